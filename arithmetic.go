@@ -0,0 +1,227 @@
+package interval
+
+import "math"
+
+// The arithmetic functions treat their operands as the closed intervals [left,right] and return
+// an interval which is guaranteed to enclose every possible result. Computed endpoints are
+// rounded outward by one ulp with math.Nextafter, so results may be slightly wider than the tightest
+// enclosure. Finite endpoints of results are closed and infinite endpoints are open.
+
+var (
+	negInf = math.Inf(-1)
+	posInf = math.Inf(1)
+)
+
+func roundDown(v float64) float64 {
+	return math.Nextafter(v, negInf)
+}
+
+func roundUp(v float64) float64 {
+	return math.Nextafter(v, posInf)
+}
+
+// enclose returns [lo,hi] with infinite endpoints open
+func enclose(lo, hi float64) *BaseInterval[float64] {
+	t := Closed
+	if math.IsInf(lo, -1) {
+		t &^= ClosedOpen
+	}
+	if math.IsInf(hi, 1) {
+		t &^= OpenClosed
+	}
+	return NewBaseInterval[float64](lo, hi, t)
+}
+
+// minBound and maxBound skip NaN candidates such as Inf/Inf which do not bound the result
+func minBound(candidates ...float64) float64 {
+	r := math.NaN()
+	for _, c := range candidates {
+		if math.IsNaN(r) || c < r {
+			r = c
+		}
+	}
+	return r
+}
+
+func maxBound(candidates ...float64) float64 {
+	r := math.NaN()
+	for _, c := range candidates {
+		if math.IsNaN(r) || c > r {
+			r = c
+		}
+	}
+	return r
+}
+
+// mulDown and mulUp return the rounded bounds of x*y, a zero factor gives an exact zero even with an infinite factor
+func mulDown(x, y float64) float64 {
+	if x == 0 || y == 0 {
+		return 0
+	}
+	return roundDown(x * y)
+}
+
+func mulUp(x, y float64) float64 {
+	if x == 0 || y == 0 {
+		return 0
+	}
+	return roundUp(x * y)
+}
+
+// divDown and divUp return the rounded bounds of x/y for non zero y, a zero dividend gives an exact zero
+func divDown(x, y float64) float64 {
+	if x == 0 {
+		return 0
+	}
+	return roundDown(x / y)
+}
+
+func divUp(x, y float64) float64 {
+	if x == 0 {
+		return 0
+	}
+	return roundUp(x / y)
+}
+
+// Add returns an enclosure of a+b
+func Add(a, b *BaseInterval[float64]) *BaseInterval[float64] {
+	al, ar := a.ends()
+	bl, br := b.ends()
+	return enclose(roundDown(al+bl), roundUp(ar+br))
+}
+
+// Sub returns an enclosure of a-b
+func Sub(a, b *BaseInterval[float64]) *BaseInterval[float64] {
+	al, ar := a.ends()
+	bl, br := b.ends()
+	return enclose(roundDown(al-br), roundUp(ar-bl))
+}
+
+// Mul returns an enclosure of a*b
+func Mul(a, b *BaseInterval[float64]) *BaseInterval[float64] {
+	al, ar := a.ends()
+	bl, br := b.ends()
+	return enclose(
+		minBound(mulDown(al, bl), mulDown(al, br), mulDown(ar, bl), mulDown(ar, br)),
+		maxBound(mulUp(al, bl), mulUp(al, br), mulUp(ar, bl), mulUp(ar, br)),
+	)
+}
+
+// Div returns an enclosure of a/b. If b contains zero the result may be two intervals in ascending order,
+// or the whole line if a contains zero too. Nil is returned if b is [0,0]
+func Div(a, b *BaseInterval[float64]) []*BaseInterval[float64] {
+	al, ar := a.ends()
+	bl, br := b.ends()
+	switch {
+	case bl > 0 || br < 0:
+		return []*BaseInterval[float64]{enclose(
+			minBound(divDown(al, bl), divDown(al, br), divDown(ar, bl), divDown(ar, br)),
+			maxBound(divUp(al, bl), divUp(al, br), divUp(ar, bl), divUp(ar, br)),
+		)}
+	case bl == 0 && br == 0:
+		return nil
+	case al <= 0 && ar >= 0:
+		return []*BaseInterval[float64]{enclose(negInf, posInf)}
+	case ar < 0 && bl == 0:
+		return []*BaseInterval[float64]{enclose(negInf, divUp(ar, br))}
+	case ar < 0 && br == 0:
+		return []*BaseInterval[float64]{enclose(divDown(ar, bl), posInf)}
+	case ar < 0:
+		return []*BaseInterval[float64]{
+			enclose(negInf, divUp(ar, br)),
+			enclose(divDown(ar, bl), posInf),
+		}
+	case bl == 0:
+		return []*BaseInterval[float64]{enclose(divDown(al, br), posInf)}
+	case br == 0:
+		return []*BaseInterval[float64]{enclose(negInf, divUp(al, bl))}
+	}
+	return []*BaseInterval[float64]{
+		enclose(negInf, divUp(al, bl)),
+		enclose(divDown(al, br), posInf),
+	}
+}
+
+// Abs returns the interval of |x| for x in a
+func Abs(a *BaseInterval[float64]) *BaseInterval[float64] {
+	al, ar := a.ends()
+	switch {
+	case al >= 0:
+		return enclose(al, ar)
+	case ar <= 0:
+		return enclose(-ar, -al)
+	}
+	return enclose(0, math.Max(-al, ar))
+}
+
+// powDown and powUp return bounds of x^n for x >= 0 and n > 0 rounding every step
+func powDown(x float64, n int) float64 {
+	r := 1.0
+	for i := 0; i < n; i++ {
+		r = math.Max(0, mulDown(r, x))
+	}
+	return r
+}
+
+func powUp(x float64, n int) float64 {
+	r := 1.0
+	for i := 0; i < n; i++ {
+		r = mulUp(r, x)
+	}
+	return r
+}
+
+// Pow returns an enclosure of a^n, for negative n it is 1/a^-n and nil is returned if a is [0,0]
+func Pow(a *BaseInterval[float64], n int) *BaseInterval[float64] {
+	al, ar := a.ends()
+	switch {
+	case n == 0:
+		return enclose(1, 1)
+	case n < 0:
+		q := Div(enclose(1, 1), Pow(a, -n))
+		if len(q) == 0 {
+			return nil
+		}
+		// a split quotient is widened to the hull of its pieces
+		return enclose(q[0].Left(), q[len(q)-1].Right())
+	case n%2 == 1:
+		lo, hi := powDown(math.Abs(al), n), powUp(math.Abs(ar), n)
+		if al < 0 {
+			lo = -powUp(-al, n)
+		}
+		if ar < 0 {
+			hi = -powDown(-ar, n)
+		}
+		return enclose(lo, hi)
+	}
+	abs := Abs(a)
+	return enclose(powDown(abs.Left(), n), powUp(abs.Right(), n))
+}
+
+// Sqrt returns an enclosure of the square root of the non negative part of a, nil if a is negative
+func Sqrt(a *BaseInterval[float64]) *BaseInterval[float64] {
+	al, ar := a.ends()
+	if ar < 0 {
+		return nil
+	}
+	return enclose(math.Max(0, roundDown(math.Sqrt(math.Max(0, al)))), roundUp(math.Sqrt(ar)))
+}
+
+// Exp returns an enclosure of e^a
+func Exp(a *BaseInterval[float64]) *BaseInterval[float64] {
+	al, ar := a.ends()
+	return enclose(math.Max(0, roundDown(math.Exp(al))), roundUp(math.Exp(ar)))
+}
+
+// Log returns an enclosure of the natural logarithm of the positive part of a, nil if a is not positive
+func Log(a *BaseInterval[float64]) *BaseInterval[float64] {
+	al, ar := a.ends()
+	if ar <= 0 {
+		return nil
+	}
+	lo := negInf
+	if al > 0 {
+		lo = roundDown(math.Log(al))
+	}
+	return enclose(lo, roundUp(math.Log(ar)))
+}
@@ -0,0 +1,90 @@
+package interval
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func point(v float64) *BaseInterval[float64] {
+	return NewBaseInterval[float64](v, v, Closed)
+}
+
+func TestArithmetic(t *testing.T) {
+	a := NewBaseInterval[float64](1, 2, Closed)
+	b := NewBaseInterval[float64](-3, 4, Closed)
+	tests := []struct {
+		name   string
+		got    *BaseInterval[float64]
+		lo, hi float64
+	}{
+		{name: "add", got: Add(a, b), lo: -2, hi: 6},
+		{name: "sub", got: Sub(a, b), lo: -3, hi: 5},
+		{name: "mul", got: Mul(a, b), lo: -6, hi: 8},
+		{name: "mulInf", got: Mul(NewBaseInterval[float64](0, 1, Closed), NewBaseInterval(1, math.Inf(1), ClosedOpen)), lo: 0, hi: math.Inf(1)},
+		{name: "abs", got: Abs(b), lo: 0, hi: 4},
+		{name: "powEven", got: Pow(b, 2), lo: 0, hi: 16},
+		{name: "powOdd", got: Pow(b, 3), lo: -27, hi: 64},
+		{name: "powNeg", got: Pow(a, -1), lo: 0.5, hi: 1},
+		{name: "sqrt", got: Sqrt(NewBaseInterval[float64](-1, 4, Closed)), lo: 0, hi: 2},
+		{name: "exp", got: Exp(NewBaseInterval[float64](0, 1, Closed)), lo: 1, hi: math.E},
+		{name: "log", got: Log(NewBaseInterval[float64](0, 1, Closed)), lo: math.Inf(-1), hi: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.Left() > tt.lo || tt.got.Right() < tt.hi {
+				t.Errorf("%s = %v, want enclosure of [%v,%v]", tt.name, tt.got, tt.lo, tt.hi)
+			}
+			if tt.lo-tt.got.Left() > 1e-12*math.Abs(tt.lo)+1e-300 || tt.got.Right()-tt.hi > 1e-12*math.Abs(tt.hi)+1e-300 {
+				t.Errorf("%s = %v, too wide for [%v,%v]", tt.name, tt.got, tt.lo, tt.hi)
+			}
+		})
+	}
+}
+
+func TestAdd_OutwardRounding(t *testing.T) {
+	// 0.1+0.2 is not representable, the result must enclose both neighbours of the true value
+	got := Add(point(0.1), point(0.2))
+	if !got.Contains(0.30000000000000004) || !got.Contains(0.3) {
+		t.Errorf("Add() = %v, want enclosure of 0.3", got)
+	}
+	if !got.LeftClosed() || !got.RightClosed() {
+		t.Errorf("Add() = %v, want closed", got)
+	}
+}
+
+func TestDiv(t *testing.T) {
+	inf := math.Inf(1)
+	tests := []struct {
+		name string
+		a, b *BaseInterval[float64]
+		want [][2]float64
+	}{
+		{name: "positive", a: NewBaseInterval[float64](1, 2, Closed), b: NewBaseInterval[float64](4, 8, Closed), want: [][2]float64{{0.125, 0.5}}},
+		{name: "zero", a: NewBaseInterval[float64](1, 2, Closed), b: point(0), want: nil},
+		{name: "bothContainZero", a: NewBaseInterval[float64](-1, 2, Closed), b: NewBaseInterval[float64](-1, 1, Closed), want: [][2]float64{{-inf, inf}}},
+		{name: "split", a: NewBaseInterval[float64](1, 2, Closed), b: NewBaseInterval[float64](-2, 4, Closed), want: [][2]float64{{-inf, -0.5}, {0.25, inf}}},
+		{name: "rightZero", a: NewBaseInterval[float64](-2, -1, Closed), b: NewBaseInterval[float64](-4, 0, Closed), want: [][2]float64{{0.25, inf}}},
+		{name: "leftZero", a: NewBaseInterval[float64](-2, -1, Closed), b: NewBaseInterval[float64](0, 4, Closed), want: [][2]float64{{-inf, -0.25}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got [][2]float64
+			for _, q := range Div(tt.a, tt.b) {
+				got = append(got, [2]float64{q.Left(), q.Right()})
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Div() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i][0] > tt.want[i][0] || got[i][1] < tt.want[i][1] ||
+					got[i][0] < roundDown(tt.want[i][0]) || got[i][1] > roundUp(tt.want[i][1]) {
+					t.Errorf("Div() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+	if got, want := Div(point(1), NewBaseInterval[float64](0, 1, Closed))[0], NewBaseInterval(roundDown(1), inf, ClosedOpen); !reflect.DeepEqual(got, want) {
+		t.Errorf("Div() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,191 @@
+package interval
+
+import (
+	"math"
+	"sort"
+)
+
+// OwnedRange is a range of a RangeAssignment with its owner
+type OwnedRange[O comparable] struct {
+	Range *BaseInterval[uint64]
+	Owner O
+}
+
+// RangeMove is a range whose owner differs between two assignments,
+// OldAssigned and NewAssigned are false if the range has no owner in the old or new assignment
+type RangeMove[O comparable] struct {
+	Range       *BaseInterval[uint64]
+	Old         O
+	OldAssigned bool
+	New         O
+	NewAssigned bool
+}
+
+// ownedSpan is the closed range [first,last] with its owner
+type ownedSpan[O comparable] struct {
+	first uint64
+	last  uint64
+	owner O
+}
+
+// RangeAssignment maps disjoint ranges of uint64 keys, such as hash ranges, to owners
+type RangeAssignment[O comparable] struct {
+	spans []ownedSpan[O]
+}
+
+// NewRangeAssignment returns an empty RangeAssignment
+func NewRangeAssignment[O comparable]() *RangeAssignment[O] {
+	return &RangeAssignment[O]{}
+}
+
+// Clone returns a copy of this assignment which can be changed independently
+func (ra *RangeAssignment[O]) Clone() *RangeAssignment[O] {
+	return &RangeAssignment[O]{spans: append([]ownedSpan[O]{}, ra.spans...)}
+}
+
+// Assign assigns the range to owner, the parts of other ranges it overlaps are reassigned
+func (ra *RangeAssignment[O]) Assign(bi *BaseInterval[uint64], owner O) {
+	first, last, ok := integerDomain[uint64]().members(bi.values())
+	if !ok {
+		return
+	}
+	ra.unassign(first, last)
+	ra.spans = append(ra.spans, ownedSpan[O]{first: first, last: last, owner: owner})
+	sort.Slice(ra.spans, func(i, j int) bool {
+		return ra.spans[i].first < ra.spans[j].first
+	})
+}
+
+// Unassign removes the owner of the range
+func (ra *RangeAssignment[O]) Unassign(bi *BaseInterval[uint64]) {
+	if first, last, ok := integerDomain[uint64]().members(bi.values()); ok {
+		ra.unassign(first, last)
+	}
+}
+
+func (ra *RangeAssignment[O]) unassign(first, last uint64) {
+	spans := make([]ownedSpan[O], 0, len(ra.spans)+1)
+	for _, s := range ra.spans {
+		if s.last < first || s.first > last {
+			spans = append(spans, s)
+			continue
+		}
+		if s.first < first {
+			spans = append(spans, ownedSpan[O]{first: s.first, last: first - 1, owner: s.owner})
+		}
+		if s.last > last {
+			spans = append(spans, ownedSpan[O]{first: last + 1, last: s.last, owner: s.owner})
+		}
+	}
+	ra.spans = spans
+}
+
+// Reassign moves all ranges of owner from to owner to, as when a node leaves
+func (ra *RangeAssignment[O]) Reassign(from, to O) {
+	for i := range ra.spans {
+		if ra.spans[i].owner == from {
+			ra.spans[i].owner = to
+		}
+	}
+}
+
+// Split splits the range containing key into two ranges of the same owner, the second one starting at key.
+// It returns false if no range contains key or a range already starts at key
+func (ra *RangeAssignment[O]) Split(key uint64) bool {
+	i := ra.search(key)
+	if i < 0 || ra.spans[i].first == key {
+		return false
+	}
+	s := ra.spans[i]
+	ra.spans = append(ra.spans[:i+1], ra.spans[i:]...)
+	ra.spans[i] = ownedSpan[O]{first: s.first, last: key - 1, owner: s.owner}
+	ra.spans[i+1] = ownedSpan[O]{first: key, last: s.last, owner: s.owner}
+	return true
+}
+
+// Merge merges the range starting at key into the adjacent range before it, which keeps its owner.
+// It returns false if there are no such two adjacent ranges
+func (ra *RangeAssignment[O]) Merge(key uint64) bool {
+	i := ra.search(key)
+	if i <= 0 || ra.spans[i].first != key || ra.spans[i-1].last != key-1 {
+		return false
+	}
+	ra.spans[i-1].last = ra.spans[i].last
+	ra.spans = append(ra.spans[:i], ra.spans[i+1:]...)
+	return true
+}
+
+// search returns the index of the range containing key, -1 if there is none
+func (ra *RangeAssignment[O]) search(key uint64) int {
+	i := sort.Search(len(ra.spans), func(i int) bool {
+		return ra.spans[i].last >= key
+	})
+	if i < len(ra.spans) && ra.spans[i].first <= key {
+		return i
+	}
+	return -1
+}
+
+// Owner returns the owner of key, ok is false if key is not assigned
+func (ra *RangeAssignment[O]) Owner(key uint64) (owner O, ok bool) {
+	if i := ra.search(key); i >= 0 {
+		return ra.spans[i].owner, true
+	}
+	return owner, false
+}
+
+// Ranges returns the closed assigned ranges in ascending order
+func (ra *RangeAssignment[O]) Ranges() []OwnedRange[O] {
+	ranges := make([]OwnedRange[O], 0, len(ra.spans))
+	for _, s := range ra.spans {
+		ranges = append(ranges, OwnedRange[O]{Range: NewBaseInterval(s.first, s.last, Closed), Owner: s.owner})
+	}
+	return ranges
+}
+
+// DiffAssignments returns the closed ranges whose owner differs between the assignments from and to
+// in ascending order, adjacent ranges with the same change are merged
+func DiffAssignments[O comparable](from, to *RangeAssignment[O]) []RangeMove[O] {
+	// the elementary pieces start at 0 and after every end of a range
+	starts := []uint64{0}
+	for _, spans := range [][]ownedSpan[O]{from.spans, to.spans} {
+		for _, s := range spans {
+			starts = append(starts, s.first)
+			if s.last != math.MaxUint64 {
+				starts = append(starts, s.last+1)
+			}
+		}
+	}
+	sort.Slice(starts, func(i, j int) bool {
+		return starts[i] < starts[j]
+	})
+	n := 0
+	for _, start := range starts {
+		if n == 0 || starts[n-1] != start {
+			starts[n] = start
+			n++
+		}
+	}
+	starts = starts[:n]
+
+	var moves []RangeMove[O]
+	for i, first := range starts {
+		last := uint64(math.MaxUint64)
+		if i+1 < len(starts) {
+			last = starts[i+1] - 1
+		}
+		move := RangeMove[O]{Range: NewBaseInterval(first, last, Closed)}
+		move.Old, move.OldAssigned = from.Owner(first)
+		move.New, move.NewAssigned = to.Owner(first)
+		if move.OldAssigned == move.NewAssigned && (!move.OldAssigned || move.Old == move.New) {
+			continue
+		}
+		if n := len(moves); n > 0 && moves[n-1].Range.Right() == first-1 && moves[n-1].OldAssigned == move.OldAssigned &&
+			moves[n-1].NewAssigned == move.NewAssigned && moves[n-1].Old == move.Old && moves[n-1].New == move.New {
+			moves[n-1].Range = NewBaseInterval(moves[n-1].Range.Left(), last, Closed)
+			continue
+		}
+		moves = append(moves, move)
+	}
+	return moves
+}
@@ -0,0 +1,68 @@
+package interval
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestRangeAssignment(t *testing.T) {
+	ra := NewRangeAssignment[string]()
+	ra.Assign(NewBaseInterval[uint64](0, 100), "a")
+	ra.Assign(NewBaseInterval[uint64](100, math.MaxUint64, Closed), "b")
+	ra.Assign(NewBaseInterval[uint64](50, 150), "c")
+	want := []OwnedRange[string]{
+		{Range: NewBaseInterval[uint64](0, 49, Closed), Owner: "a"},
+		{Range: NewBaseInterval[uint64](50, 149, Closed), Owner: "c"},
+		{Range: NewBaseInterval[uint64](150, math.MaxUint64, Closed), Owner: "b"},
+	}
+	if got := ra.Ranges(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Ranges() = %v, want %v", got, want)
+	}
+	if owner, ok := ra.Owner(149); !ok || owner != "c" {
+		t.Errorf("Owner() = %v %v, want c", owner, ok)
+	}
+
+	if !ra.Split(100) || ra.Split(100) || ra.Split(0) {
+		t.Errorf("Split() should only split inside a range")
+	}
+	if got := len(ra.Ranges()); got != 4 {
+		t.Errorf("Split() gives %d ranges, want 4", got)
+	}
+	if !ra.Merge(100) || ra.Merge(100) {
+		t.Errorf("Merge() should only merge adjacent ranges")
+	}
+	if got := ra.Ranges(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Merge() = %v, want %v", got, want)
+	}
+
+	ra.Unassign(NewBaseInterval[uint64](10, 20, Closed))
+	if _, ok := ra.Owner(15); ok {
+		t.Errorf("Owner() of an unassigned key should not be ok")
+	}
+}
+
+func TestDiffAssignments(t *testing.T) {
+	from := NewRangeAssignment[int]()
+	from.Assign(NewBaseInterval[uint64](0, 1000), 1)
+	from.Assign(NewBaseInterval[uint64](1000, 2000), 2)
+
+	// node 3 joins and takes the end of both ranges, then node 2 leaves
+	to := from.Clone()
+	to.Assign(NewBaseInterval[uint64](900, 1000), 3)
+	to.Assign(NewBaseInterval[uint64](1900, 2100), 3)
+	to.Reassign(2, 1)
+
+	want := []RangeMove[int]{
+		{Range: NewBaseInterval[uint64](900, 999, Closed), Old: 1, OldAssigned: true, New: 3, NewAssigned: true},
+		{Range: NewBaseInterval[uint64](1000, 1899, Closed), Old: 2, OldAssigned: true, New: 1, NewAssigned: true},
+		{Range: NewBaseInterval[uint64](1900, 1999, Closed), Old: 2, OldAssigned: true, New: 3, NewAssigned: true},
+		{Range: NewBaseInterval[uint64](2000, 2099, Closed), New: 3, NewAssigned: true},
+	}
+	if got := DiffAssignments(from, to); !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffAssignments() = %v, want %v", got, want)
+	}
+	if got := DiffAssignments(from, from.Clone()); len(got) != 0 {
+		t.Errorf("DiffAssignments() = %v, want none", got)
+	}
+}
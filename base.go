@@ -1,129 +1,232 @@
 package interval
 
 import (
-	"bytes"
+	"errors"
 	"fmt"
+	"math"
+	"reflect"
 	"strconv"
 )
 
 type BaseInterval[T baseSortable] struct {
-	left           T
-	right          T
-	openClosedType OpenClosedType
+	bounds[T]
 }
 
 func NewBaseInterval[T baseSortable](left, right T, openCloseType ...OpenClosedType) *BaseInterval[T] {
-	t := Default
-	if len(openCloseType) > 0 {
-		t = openCloseType[0]
-	}
-	return &BaseInterval[T]{
-		left:           left,
-		right:          right,
-		openClosedType: t,
-	}
+	return &BaseInterval[T]{bounds: newBounds(left, right, openCloseType)}
+}
+
+// NewBaseIntervalFromBounds return a new BaseInterval between the given bounds, which may be Unbounded
+func NewBaseIntervalFromBounds[T baseSortable](lower, upper Bound[T]) *BaseInterval[T] {
+	return &BaseInterval[T]{bounds: bounds[T]{lower: lower, upper: upper}}
+}
+
+func newBaseIntervalCuts[T baseSortable](lower, upper cut[T]) *BaseInterval[T] {
+	return NewBaseIntervalFromBounds(lowerBound(lower), upperBound(upper))
 }
 
 // ParseStrInterval parse str to interval
 func ParseStrInterval(intervalStr string) (i *BaseInterval[string], err error) {
-	var lf, lv, rv, rf string
-	if lf, lv, rv, rf, err = blowUp(intervalStr); err != nil {
-		return
-	}
+	return DefaultGrammar.ParseStrInterval(intervalStr)
+}
+
+// ParseStrInterval parse str to interval with this grammar
+func (g *Grammar) ParseStrInterval(intervalStr string) (i *BaseInterval[string], err error) {
 	var openClosedType OpenClosedType
-	if openClosedType, err = getOpenClosedType(lf, rf); err != nil {
-		return nil, err
+	var lv, rv string
+	if openClosedType, lv, rv, err = g.blowUp(intervalStr); err != nil {
+		return
 	}
 	return NewBaseInterval[string](lv, rv, openClosedType), nil
 }
 
-// ParseIntInterval parse str to interval
+// ParseIntInterval parse str to interval, the infinity tokens are parsed to Unbounded ends
 func ParseIntInterval(intervalStr string) (i *BaseInterval[int64], err error) {
-	var lf, lv, rv, rf string
-	if lf, lv, rv, rf, err = blowUp(intervalStr); err != nil {
-		return
-	}
+	return DefaultGrammar.ParseIntInterval(intervalStr)
+}
+
+// ParseIntInterval parse str to interval with this grammar, the infinity tokens are parsed to Unbounded ends
+func (g *Grammar) ParseIntInterval(intervalStr string) (i *BaseInterval[int64], err error) {
 	var openClosedType OpenClosedType
-	if openClosedType, err = getOpenClosedType(lf, rf); err != nil {
-		return nil, err
-	}
-	var lfv, rfv int64
-	if lfv, err = strconv.ParseInt(lv, 10, 64); err != nil {
+	var lv, rv string
+	if openClosedType, lv, rv, err = g.blowUp(intervalStr); err != nil {
 		return
 	}
-	if rfv, err = strconv.ParseInt(rv, 10, 64); err != nil {
+	var lower, upper Bound[int64]
+	if lower, upper, err = parseBounds(g, openClosedType, lv, rv, func(v string) (int64, error) {
+		return strconv.ParseInt(v, 10, 64)
+	}); err != nil {
 		return
 	}
-	return NewBaseInterval[int64](lfv, rfv, openClosedType), nil
+	return NewBaseIntervalFromBounds(lower, upper), nil
 }
 
-// ParseFloatInterval parse str to interval
+// ParseFloatInterval parse str to interval, the infinity tokens are parsed to the bounded values ±Inf,
+// not to Unbounded ends, see Grammar.PosInfTokens
 func ParseFloatInterval(intervalStr string) (i *BaseInterval[float64], err error) {
-	var lf, lv, rv, rf string
-	if lf, lv, rv, rf, err = blowUp(intervalStr); err != nil {
-		return
-	}
+	return DefaultGrammar.ParseFloatInterval(intervalStr)
+}
+
+// ParseFloatInterval parse str to interval with this grammar, the infinity tokens are parsed to the bounded values ±Inf
+func (g *Grammar) ParseFloatInterval(intervalStr string) (i *BaseInterval[float64], err error) {
 	var openClosedType OpenClosedType
-	if openClosedType, err = getOpenClosedType(lf, rf); err != nil {
-		return nil, err
+	var lv, rv string
+	if openClosedType, lv, rv, err = g.blowUp(intervalStr); err != nil {
+		return
 	}
 	var lfv, rfv float64
-	if lfv, err = strconv.ParseFloat(lv, 64); err != nil {
+	if lfv, err = g.parseFloat(lv); err != nil {
 		return
 	}
-	if rfv, err = strconv.ParseFloat(rv, 64); err != nil {
+	if rfv, err = g.parseFloat(rv); err != nil {
 		return
 	}
 	return NewBaseInterval[float64](lfv, rfv, openClosedType), nil
 }
 
-// Left returns the left value of this interval
-func (bi *BaseInterval[T]) Left() T {
-	return bi.left
+func (g *Grammar) parseFloat(v string) (float64, error) {
+	if sign := g.infinity(v); sign != 0 {
+		return math.Inf(sign), nil
+	}
+	return strconv.ParseFloat(g.number(v), 64)
 }
 
-// Right returns the right value of this interval
-func (bi *BaseInterval[T]) Right() T {
-	return bi.right
+// ParseBaseInterval parse str to interval of any baseSortable type, the values are parsed by the strconv routine
+// of the underlying kind of T. Integers accept Go literals like "0x1F", "0o17", "0b101" and "1_000",
+// a value which does not fit T returns a *ValueRangeError
+func ParseBaseInterval[T baseSortable](intervalStr string) (i *BaseInterval[T], err error) {
+	return ParseBaseIntervalWith[T](&DefaultGrammar, intervalStr)
 }
 
-// OpenClosedType returns the OpenClosedType of this interval
-func (bi *BaseInterval[T]) OpenClosedType() OpenClosedType {
-	return bi.openClosedType
+// ParseBaseIntervalWith is ParseBaseInterval with the given grammar, the infinity tokens are parsed to
+// the bounded infinities of float kinds and to Unbounded ends of integer kinds, see Grammar.PosInfTokens
+func ParseBaseIntervalWith[T baseSortable](g *Grammar, intervalStr string) (i *BaseInterval[T], err error) {
+	var openClosedType OpenClosedType
+	var lv, rv string
+	if openClosedType, lv, rv, err = g.blowUp(intervalStr); err != nil {
+		return
+	}
+	parse := func(v string) (T, error) {
+		return parseBaseValue[T](g, v)
+	}
+	switch reflect.ValueOf(*new(T)).Kind() {
+	case reflect.Float32, reflect.Float64, reflect.String:
+		var lfv, rfv T
+		if lfv, err = parse(lv); err != nil {
+			return
+		}
+		if rfv, err = parse(rv); err != nil {
+			return
+		}
+		return NewBaseInterval[T](lfv, rfv, openClosedType), nil
+	}
+	var lower, upper Bound[T]
+	if lower, upper, err = parseBounds(g, openClosedType, lv, rv, parse); err != nil {
+		return
+	}
+	return NewBaseIntervalFromBounds(lower, upper), nil
+}
+
+// parseBaseValue parse str to a value of T with the strconv routine of its underlying kind
+func parseBaseValue[T baseSortable](g *Grammar, str string) (v T, err error) {
+	rv := reflect.ValueOf(&v).Elem()
+	if sign := g.infinity(str); sign != 0 && rv.CanFloat() {
+		rv.SetFloat(math.Inf(sign))
+		return v, nil
+	}
+	t := rv.Type()
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		if n, err = strconv.ParseInt(str, 0, t.Bits()); err == nil {
+			rv.SetInt(n)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var n uint64
+		if n, err = strconv.ParseUint(str, 0, t.Bits()); err == nil {
+			rv.SetUint(n)
+		} else if _, ierr := strconv.ParseInt(str, 0, 64); ierr == nil || errors.Is(ierr, strconv.ErrRange) {
+			// a well formed negative value is below the range of unsigned kinds
+			err = strconv.ErrRange
+		}
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		if f, err = strconv.ParseFloat(g.number(str), t.Bits()); err == nil {
+			rv.SetFloat(f)
+		}
+	default:
+		rv.SetString(str)
+	}
+	if errors.Is(err, strconv.ErrRange) {
+		return v, &ValueRangeError{Value: str, Type: t.String()}
+	}
+	return v, err
+}
+
+// Left returns the left value of this interval, or the zero value of T if it is Unbounded
+func (bi *BaseInterval[T]) Left() T {
+	return bi.lower.value
+}
+
+// Right returns the right value of this interval, or the zero value of T if it is Unbounded
+func (bi *BaseInterval[T]) Right() T {
+	return bi.upper.value
 }
 
-// LeftClosed returns true if this interval is a left-closed interval
-func (bi *BaseInterval[T]) LeftClosed() bool {
-	return bi.openClosedType&ClosedOpen == ClosedOpen
+// ends returns the left and the right value of this interval, an Unbounded end of a numeric interval
+// is the extreme of T like math.MinInt64 or +Inf, so the finite algorithms of the package cover the whole range of T
+func (bi *BaseInterval[T]) ends() (left, right T) {
+	left, right = bi.lower.value, bi.upper.value
+	if bi.lower.kind == Unbounded {
+		left = extreme[T](-1)
+	}
+	if bi.upper.kind == Unbounded {
+		right = extreme[T](1)
+	}
+	return left, right
 }
 
-// RightClosed returns true if this interval is a right-closed interval
-func (bi *BaseInterval[T]) RightClosed() bool {
-	return bi.openClosedType&OpenClosed == OpenClosed
+// values returns the ends and the OpenClosedType of this interval, an Unbounded end is the closed extreme of T
+func (bi *BaseInterval[T]) values() (left, right T, t OpenClosedType) {
+	t = bi.OpenClosedType()
+	if bi.lower.kind == Unbounded {
+		t |= ClosedOpen
+	}
+	if bi.upper.kind == Unbounded {
+		t |= OpenClosed
+	}
+	left, right = bi.ends()
+	return left, right, t
 }
 
 // Contains returns true if the given element is in this interval
 func (bi *BaseInterval[T]) Contains(e T) bool {
-	return (e > bi.left && e < bi.right) ||
-		(e == bi.right && bi.openClosedType&OpenClosed == OpenClosed) ||
-		(e == bi.left && bi.openClosedType&ClosedOpen == ClosedOpen)
+	// NaN is not in any interval
+	return e == e && bi.contains(compareBase[T], e)
 }
 
-// String returns a readable string of this interval
+// String returns a readable string of this interval, Unbounded ends are written as "-inf" and "+inf"
 func (bi *BaseInterval[T]) String() string {
-	bs := &bytes.Buffer{}
-	if bi.LeftClosed() {
-		bs.WriteString(LeftClosed)
-	} else {
-		bs.WriteString(LeftOpen)
-	}
-	bs.WriteString(fmt.Sprint(bi.left))
-	bs.WriteString(Spacer)
-	bs.WriteString(fmt.Sprint(bi.right))
-	if bi.RightClosed() {
-		bs.WriteString(RightClosed)
-	} else {
-		bs.WriteString(RightOpen)
-	}
-	return bs.String()
+	return bi.string(func(v T) string { return fmt.Sprint(v) }, "-inf", "+inf")
+}
+
+// extreme returns the smallest or the largest value of a numeric T depending on sign, or the zero value
+func extreme[T baseSortable](sign int) (v T) {
+	rv := reflect.ValueOf(&v).Elem()
+	bits := rv.Type().Bits
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if sign > 0 {
+			rv.SetInt(math.MaxInt64 >> (64 - bits()))
+		} else {
+			rv.SetInt(math.MinInt64 >> (64 - bits()))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if sign > 0 {
+			rv.SetUint(math.MaxUint64 >> (64 - bits()))
+		}
+	case reflect.Float32, reflect.Float64:
+		rv.SetFloat(math.Inf(sign))
+	}
+	return v
 }
@@ -1,6 +1,7 @@
 package interval
 
 import (
+	"errors"
 	"math"
 	"reflect"
 	"testing"
@@ -118,3 +119,50 @@ func TestParseFloatInterval(t *testing.T) {
 		})
 	}
 }
+
+type testCelsius float32
+
+func TestParseBaseInterval(t *testing.T) {
+	u, err := ParseBaseInterval[uint64]("[0x10, 18446744073709551615]")
+	if err != nil || !reflect.DeepEqual(u, NewBaseInterval[uint64](16, math.MaxUint64, Closed)) {
+		t.Errorf("ParseBaseInterval[uint64]() = %v, %v", u, err)
+	}
+	i, err := ParseBaseInterval[int32]("(-0b101, 1_000_000)")
+	if err != nil || !reflect.DeepEqual(i, NewBaseInterval[int32](-5, 1000000, Open)) {
+		t.Errorf("ParseBaseInterval[int32]() = %v, %v", i, err)
+	}
+	c, err := ParseBaseInterval[testCelsius]("[-1.5, 0o17)")
+	if err == nil {
+		t.Errorf("ParseBaseInterval[testCelsius]() = %v, want error", c)
+	}
+	c, err = ParseBaseInterval[testCelsius]("[-1.5, 36.6)")
+	if err != nil || !reflect.DeepEqual(c, NewBaseInterval[testCelsius](-1.5, 36.6, ClosedOpen)) {
+		t.Errorf("ParseBaseInterval[testCelsius]() = %v, %v", c, err)
+	}
+	if _, err = ParseBaseInterval[uint8]("[1, x]"); err == nil || errors.Is(err, ValueRangeErr) {
+		t.Errorf("ParseBaseInterval[uint8]() error = %v, want syntax error", err)
+	}
+	s, err := ParseBaseInterval[string]("[a, b]")
+	if err != nil || !reflect.DeepEqual(s, NewBaseInterval[string]("a", "b", Closed)) {
+		t.Errorf("ParseBaseInterval[string]() = %v, %v", s, err)
+	}
+
+	overflows := []struct {
+		name string
+		fn   func() error
+	}{
+		{name: "int8", fn: func() error { _, err := ParseBaseInterval[int8]("[0, 128]"); return err }},
+		{name: "uint16", fn: func() error { _, err := ParseBaseInterval[uint16]("[-1, 2]"); return err }},
+		{name: "uint32", fn: func() error { _, err := ParseBaseInterval[uint32]("[0, 0x100000000]"); return err }},
+		{name: "float32", fn: func() error { _, err := ParseBaseInterval[float32]("[0, 1e39]"); return err }},
+	}
+	for _, tt := range overflows {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.fn()
+			var rangeErr *ValueRangeError
+			if !errors.As(err, &rangeErr) || !errors.Is(err, ValueRangeErr) || rangeErr.Type != tt.name {
+				t.Errorf("ParseBaseInterval() error = %v, want %v", err, ValueRangeErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package interval
+
+import (
+	"math/big"
+)
+
+// NewBigIntInterval return a new CmpInterval of *big.Int
+func NewBigIntInterval(left, right *big.Int, openCloseType ...OpenClosedType) *CmpInterval[*big.Int] {
+	return NewCmpInterval((*big.Int).Cmp, left, right, openCloseType...)
+}
+
+// NewBigRatInterval return a new CmpInterval of *big.Rat
+func NewBigRatInterval(left, right *big.Rat, openCloseType ...OpenClosedType) *CmpInterval[*big.Rat] {
+	return NewCmpInterval((*big.Rat).Cmp, left, right, openCloseType...)
+}
+
+// NewBigFloatInterval return a new CmpInterval of *big.Float
+func NewBigFloatInterval(left, right *big.Float, openCloseType ...OpenClosedType) *CmpInterval[*big.Float] {
+	return NewCmpInterval((*big.Float).Cmp, left, right, openCloseType...)
+}
+
+// ParseBigIntInterval parse str to *big.Int interval, the values may be any size like "[0,18446744073709551616)"
+func ParseBigIntInterval(intervalStr string) (*CmpInterval[*big.Int], error) {
+	return DefaultGrammar.ParseBigIntInterval(intervalStr)
+}
+
+// ParseBigIntInterval parse str to *big.Int interval with this grammar
+func (g *Grammar) ParseBigIntInterval(intervalStr string) (*CmpInterval[*big.Int], error) {
+	return ParseCmpIntervalWith(g, intervalStr, func(s string) (*big.Int, error) {
+		i, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return nil, ValueStrErr
+		}
+		return i, nil
+	}, (*big.Int).Cmp)
+}
+
+// ParseBigRatInterval parse str to *big.Rat interval, decimal values like "[0.10, 0.30)" and fractions like "1/3"
+// are parsed exactly
+func ParseBigRatInterval(intervalStr string) (*CmpInterval[*big.Rat], error) {
+	return DefaultGrammar.ParseBigRatInterval(intervalStr)
+}
+
+// ParseBigRatInterval parse str to *big.Rat interval with this grammar
+func (g *Grammar) ParseBigRatInterval(intervalStr string) (*CmpInterval[*big.Rat], error) {
+	return ParseCmpIntervalWith(g, intervalStr, func(s string) (*big.Rat, error) {
+		r, ok := new(big.Rat).SetString(g.number(s))
+		if !ok {
+			return nil, ValueStrErr
+		}
+		return r, nil
+	}, (*big.Rat).Cmp)
+}
+
+// ParseBigFloatInterval parse str to *big.Float interval with the given precision in bits, 64 if prec is 0.
+// A decimal value which is not exact in binary is rounded outward, the left value down and the right value up,
+// so the interval always encloses the written one
+func ParseBigFloatInterval(intervalStr string, prec uint) (*CmpInterval[*big.Float], error) {
+	return DefaultGrammar.ParseBigFloatInterval(intervalStr, prec)
+}
+
+// ParseBigFloatInterval parse str to *big.Float interval with this grammar
+func (g *Grammar) ParseBigFloatInterval(intervalStr string, prec uint) (*CmpInterval[*big.Float], error) {
+	if prec == 0 {
+		prec = 64
+	}
+	openClosedType, lv, rv, err := g.blowUp(intervalStr)
+	if err != nil {
+		return nil, err
+	}
+	l, err := g.parseBigFloat(lv, prec, big.ToNegativeInf)
+	if err != nil {
+		return nil, err
+	}
+	r, err := g.parseBigFloat(rv, prec, big.ToPositiveInf)
+	if err != nil {
+		return nil, err
+	}
+	return NewBigFloatInterval(l, r, openClosedType), nil
+}
+
+func (g *Grammar) parseBigFloat(v string, prec uint, mode big.RoundingMode) (*big.Float, error) {
+	if sign := g.infinity(v); sign != 0 {
+		return new(big.Float).SetPrec(prec).SetInf(sign < 0), nil
+	}
+	f, _, err := big.ParseFloat(g.number(v), 10, prec, mode)
+	if err != nil {
+		return nil, ValueStrErr
+	}
+	return f, nil
+}
@@ -0,0 +1,59 @@
+package interval
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestParseBigRatInterval(t *testing.T) {
+	ri, err := ParseBigRatInterval("[0.10, 0.30)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := new(big.Rat).Add(big.NewRat(1, 10), big.NewRat(2, 10))
+	if ri.Contains(sum) {
+		t.Errorf("Contains(%v) of %v = true, want false", sum, ri)
+	}
+	if !ri.Contains(big.NewRat(1, 10)) || !ri.Contains(big.NewRat(29, 100)) {
+		t.Errorf("Contains() of %v is wrong", ri)
+	}
+	if got := ri.String(); got != "[1/10,3/10)" {
+		t.Errorf("String() = %v", got)
+	}
+	if _, err := ParseBigRatInterval("[0.1, x)"); err != ValueStrErr {
+		t.Errorf("ParseBigRatInterval() error = %v, want %v", err, ValueStrErr)
+	}
+}
+
+func TestParseBigFloatInterval(t *testing.T) {
+	fi, err := ParseBigFloatInterval("[0.1, 0.3]", 53)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 0.1 and 0.3 are not exact in binary, the parsed interval must still enclose them
+	l, _ := fi.Left().Rat(nil)
+	r, _ := fi.Right().Rat(nil)
+	if l.Cmp(big.NewRat(1, 10)) > 0 || r.Cmp(big.NewRat(3, 10)) < 0 {
+		t.Errorf("%v does not enclose [0.1,0.3]", fi)
+	}
+}
+
+func TestBigIntIntervalSet(t *testing.T) {
+	a, _ := ParseBigIntInterval("[0, 18446744073709551616)")
+	b, _ := ParseBigIntInterval("[18446744073709551616, 36893488147419103232]")
+	c, _ := ParseBigIntInterval("(10, 20)")
+	set := NewCmpIntervalSet((*big.Int).Cmp, a, b)
+	if got := set.String(); got != "[0,36893488147419103232]" {
+		t.Errorf("Union String() = %v", got)
+	}
+	diff := set.Difference(NewCmpIntervalSet((*big.Int).Cmp, c))
+	if got := diff.String(); got != "[0,10] ∪ [20,36893488147419103232]" {
+		t.Errorf("Difference String() = %v", got)
+	}
+	if diff.Contains(big.NewInt(15)) || !diff.Contains(big.NewInt(20)) {
+		t.Errorf("Contains() of %v is wrong", diff)
+	}
+	if got := diff.Intersect(NewCmpIntervalSet((*big.Int).Cmp, c)); !got.IsEmpty() {
+		t.Errorf("Intersect() = %v, want %v", got, EmptySet)
+	}
+}
@@ -0,0 +1,207 @@
+package interval
+
+import "bytes"
+
+// BoundKind is the kind of an interval end
+type BoundKind uint8
+
+const (
+	// Unbounded is an end without value, the interval extends to infinity on that side
+	Unbounded BoundKind = iota
+	// Included is an end whose value is in the interval
+	Included
+	// Excluded is an end whose value is not in the interval
+	Excluded
+)
+
+// Bound is one end of an interval
+type Bound[T any] struct {
+	kind  BoundKind
+	value T
+}
+
+// IncludedBound returns an end whose value is in the interval
+func IncludedBound[T any](value T) Bound[T] {
+	return Bound[T]{kind: Included, value: value}
+}
+
+// ExcludedBound returns an end whose value is not in the interval
+func ExcludedBound[T any](value T) Bound[T] {
+	return Bound[T]{kind: Excluded, value: value}
+}
+
+// UnboundedBound returns an end without value
+func UnboundedBound[T any]() Bound[T] {
+	return Bound[T]{kind: Unbounded}
+}
+
+// newBound returns the bound of value, closed decides between Included and Excluded
+func newBound[T any](value T, closed bool) Bound[T] {
+	if closed {
+		return IncludedBound(value)
+	}
+	return ExcludedBound(value)
+}
+
+// Kind returns the kind of this bound
+func (b Bound[T]) Kind() BoundKind {
+	return b.kind
+}
+
+// Value returns the value of this bound, ok is false if it is Unbounded
+func (b Bound[T]) Value() (value T, ok bool) {
+	return b.value, b.kind != Unbounded
+}
+
+// lowerCut returns the cut of this bound as the lower end of an interval
+func (b Bound[T]) lowerCut() cut[T] {
+	if b.kind == Unbounded {
+		return cut[T]{above: true, inf: -1}
+	}
+	return lowerCut(b.value, b.kind == Included)
+}
+
+// upperCut returns the cut of this bound as the upper end of an interval
+func (b Bound[T]) upperCut() cut[T] {
+	if b.kind == Unbounded {
+		return cut[T]{inf: 1}
+	}
+	return upperCut(b.value, b.kind == Included)
+}
+
+// lowerBound returns the bound of a lower cut
+func lowerBound[T any](c cut[T]) Bound[T] {
+	if c.inf != 0 {
+		return UnboundedBound[T]()
+	}
+	return newBound(c.value, !c.above)
+}
+
+// upperBound returns the bound of an upper cut
+func upperBound[T any](c cut[T]) Bound[T] {
+	if c.inf != 0 {
+		return UnboundedBound[T]()
+	}
+	return newBound(c.value, c.above)
+}
+
+// compareLower compares two lower bounds, an Unbounded lower bound is the smallest
+func compareLower[T any](cmp func(a, b T) int, b1, b2 Bound[T]) int {
+	return compareCut(cmp, b1.lowerCut(), b2.lowerCut())
+}
+
+// compareUpper compares two upper bounds, an Unbounded upper bound is the largest
+func compareUpper[T any](cmp func(a, b T) int, b1, b2 Bound[T]) int {
+	return compareCut(cmp, b1.upperCut(), b2.upperCut())
+}
+
+// compareLowerUpper compares a lower bound with an upper bound, the interval between them is empty
+// unless the result is negative
+func compareLowerUpper[T any](cmp func(a, b T) int, lower, upper Bound[T]) int {
+	return compareCut(cmp, lower.lowerCut(), upper.upperCut())
+}
+
+// RangeBounds is implemented by the interval types of this package. The Left and Right methods of the
+// interval types return the zero value for an Unbounded end, or nil for NullableTimeInterval,
+// the bounds tell an Unbounded end from a zero value
+type RangeBounds[T any] interface {
+	// LowerBound returns the lower bound of the interval
+	LowerBound() Bound[T]
+	// UpperBound returns the upper bound of the interval
+	UpperBound() Bound[T]
+}
+
+// bounds is the pair of bounds the interval types are built on
+type bounds[T any] struct {
+	lower Bound[T]
+	upper Bound[T]
+}
+
+// newBounds returns the bounds of left and right with the given OpenClosedType
+func newBounds[T any](left, right T, openCloseType []OpenClosedType) bounds[T] {
+	t := Default
+	if len(openCloseType) > 0 {
+		t = openCloseType[0]
+	}
+	return bounds[T]{
+		lower: newBound(left, t&ClosedOpen == ClosedOpen),
+		upper: newBound(right, t&OpenClosed == OpenClosed),
+	}
+}
+
+// LowerBound returns the lower bound of this interval
+func (b *bounds[T]) LowerBound() Bound[T] {
+	return b.lower
+}
+
+// UpperBound returns the upper bound of this interval
+func (b *bounds[T]) UpperBound() Bound[T] {
+	return b.upper
+}
+
+// OpenClosedType returns the OpenClosedType of this interval, an Unbounded end is open
+func (b *bounds[T]) OpenClosedType() OpenClosedType {
+	t := Open
+	if b.LeftClosed() {
+		t |= ClosedOpen
+	}
+	if b.RightClosed() {
+		t |= OpenClosed
+	}
+	return t
+}
+
+// LeftClosed returns true if this interval is a left-closed interval
+func (b *bounds[T]) LeftClosed() bool {
+	return b.lower.kind == Included
+}
+
+// RightClosed returns true if this interval is a right-closed interval
+func (b *bounds[T]) RightClosed() bool {
+	return b.upper.kind == Included
+}
+
+// isBounded returns true if no end of b is Unbounded
+func (b *bounds[T]) isBounded() bool {
+	return b.lower.kind != Unbounded && b.upper.kind != Unbounded
+}
+
+// cuts returns the lower and the upper cut of this interval
+func (b *bounds[T]) cuts() (cut[T], cut[T]) {
+	return b.lower.lowerCut(), b.upper.upperCut()
+}
+
+// contains returns true if e is between the bounds
+func (b *bounds[T]) contains(cmp func(a, b T) int, e T) bool {
+	below, above := cut[T]{value: e}, cut[T]{value: e, above: true}
+	lower, upper := b.cuts()
+	return compareCut(cmp, lower, below) <= 0 && compareCut(cmp, upper, above) >= 0
+}
+
+// string returns the bracket notation of this interval, Unbounded ends are written as
+// lowerInf and upperInf and values by str
+func (b *bounds[T]) string(str func(T) string, lowerInf, upperInf string) string {
+	bs := &bytes.Buffer{}
+	if b.LeftClosed() {
+		bs.WriteString(LeftClosed)
+	} else {
+		bs.WriteString(LeftOpen)
+	}
+	if b.lower.kind == Unbounded {
+		bs.WriteString(lowerInf)
+	} else {
+		bs.WriteString(str(b.lower.value))
+	}
+	bs.WriteString(Spacer)
+	if b.upper.kind == Unbounded {
+		bs.WriteString(upperInf)
+	} else {
+		bs.WriteString(str(b.upper.value))
+	}
+	if b.RightClosed() {
+		bs.WriteString(RightClosed)
+	} else {
+		bs.WriteString(RightOpen)
+	}
+	return bs.String()
+}
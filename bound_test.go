@@ -0,0 +1,76 @@
+package interval
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBound(t *testing.T) {
+	if v, ok := IncludedBound(3).Value(); !ok || v != 3 {
+		t.Errorf("IncludedBound(3).Value() = %v, %v", v, ok)
+	}
+	if _, ok := UnboundedBound[int]().Value(); ok {
+		t.Errorf("UnboundedBound().Value() is ok")
+	}
+	cmp := compareBase[int]
+	if compareLower(cmp, UnboundedBound[int](), IncludedBound(math.MinInt)) >= 0 {
+		t.Errorf("Unbounded lower bound is not the smallest")
+	}
+	if compareUpper(cmp, UnboundedBound[int](), IncludedBound(math.MaxInt)) <= 0 {
+		t.Errorf("Unbounded upper bound is not the largest")
+	}
+	if compareLower(cmp, IncludedBound(1), ExcludedBound(1)) >= 0 || compareUpper(cmp, ExcludedBound(1), IncludedBound(1)) >= 0 {
+		t.Errorf("Included and Excluded bounds of the same value are misordered")
+	}
+	if compareLowerUpper(cmp, ExcludedBound(1), IncludedBound(1)) < 0 {
+		t.Errorf("(1,1] is not empty")
+	}
+}
+
+func TestBaseInterval_Unbounded(t *testing.T) {
+	bi := NewBaseIntervalFromBounds(ExcludedBound(10), UnboundedBound[int]())
+	if bi.Contains(10) || !bi.Contains(math.MaxInt) || bi.LeftClosed() || bi.RightClosed() {
+		t.Errorf("%v has wrong members", bi)
+	}
+	if l, r := bi.ends(); bi.Left() != 10 || bi.Right() != 0 || r != math.MaxInt || l != 10 || bi.UpperBound().Kind() != Unbounded {
+		t.Errorf("%v has ends %v, %v", bi, bi.Left(), bi.Right())
+	}
+	for _, tt := range []struct {
+		format string
+		want   string
+	}{
+		{"%v", "(10,+inf)"},
+		{"%u", "(10, ∞)"},
+		{"%l", `\left(10, \infty\right)`},
+		{"%#v", "interval.NewBaseIntervalFromBounds[int](interval.ExcludedBound[int](10), interval.UnboundedBound[int]())"},
+	} {
+		if got := fmt.Sprintf(tt.format, bi); got != tt.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+
+	set := NewBaseIntervalSet(bi, NewBaseIntervalFromBounds(UnboundedBound[int](), IncludedBound(0)))
+	if got := set.String(); got != "(-inf,0] ∪ (10,+inf)" {
+		t.Errorf("set = %v", got)
+	}
+	if got := set.Union(NewBaseIntervalSet(NewBaseInterval(0, 10, Closed))).String(); got != "(-inf,+inf)" {
+		t.Errorf("union = %v", got)
+	}
+	if got := NewIntegerSet(bi).String(); got != "[11,+inf)" {
+		t.Errorf("integer set = %v", got)
+	}
+}
+
+func TestNullableTimeInterval_Unbounded(t *testing.T) {
+	now := time.Now()
+	all := NewNullableTimeInterval(nil, nil)
+	if !all.Contains(&now) || all.Contains(nil) || all.Left() != nil || all.Right() != nil {
+		t.Errorf("%v has wrong members", all)
+	}
+	ti := NewNullableTimeInterval(&now, nil, Closed)
+	if !ti.Contains(&now) || ti.RightClosed() || ti.UpperBound().Kind() != Unbounded {
+		t.Errorf("%v has wrong members", ti)
+	}
+}
@@ -0,0 +1,176 @@
+package interval
+
+import "strings"
+
+// Box is an N-dimensional box made of one interval per axis, each axis keeps its own open closed type
+type Box[T number] struct {
+	axes []*BaseInterval[T]
+}
+
+// NewBox returns a new Box with the given axes
+func NewBox[T number](axes ...*BaseInterval[T]) *Box[T] {
+	return &Box[T]{axes: axes}
+}
+
+// ParseBox parse str like "[0,1)x[2,5]" to a box, the axes may also be separated by "×"
+func ParseBox(str string) (b *Box[float64], err error) {
+	return DefaultGrammar.ParseBox(str)
+}
+
+// ParseBox parse str to a box with this grammar
+func (g *Grammar) ParseBox(str string) (b *Box[float64], err error) {
+	b = &Box[float64]{}
+	str = strings.Trim(str, Space)
+	for str != "" {
+		end := g.axisEnd(str)
+		if end < 0 {
+			return nil, OpenClosedFlagErr
+		}
+		var axis *BaseInterval[float64]
+		if axis, err = g.ParseFloatInterval(str[:end]); err != nil {
+			return nil, err
+		}
+		b.axes = append(b.axes, axis)
+		str = strings.Trim(str[end:], Space)
+		if str == "" {
+			break
+		}
+		rest := strings.TrimPrefix(strings.TrimPrefix(strings.TrimPrefix(str, BoxSpacer), "X"), "×")
+		if rest == str {
+			return nil, ValueStrErr
+		}
+		str = strings.Trim(rest, Space)
+		if str == "" {
+			return nil, ParseTooShortErr
+		}
+	}
+	if len(b.axes) == 0 {
+		return nil, ParseTooShortErr
+	}
+	return b, nil
+}
+
+// axisEnd returns the end of the first axis of str, or -1 if there is no right flag
+func (g *Grammar) axisEnd(str string) int {
+	_, start, _ := g.flag(str, g.LeftClosed, g.LeftOpen, strings.HasPrefix)
+	end := -1
+	for _, token := range []string{g.RightClosed, g.RightOpen} {
+		if i := strings.Index(str[start:], token); i >= 0 && (end < 0 || start+i+len(token) < end) {
+			end = start + i + len(token)
+		}
+	}
+	return end
+}
+
+// Dims returns the number of axes
+func (b *Box[T]) Dims() int {
+	return len(b.axes)
+}
+
+// Axis returns the interval of the i-th axis
+func (b *Box[T]) Axis(i int) *BaseInterval[T] {
+	return b.axes[i]
+}
+
+// Contains returns true if the point is in this box, a point of another dimension is never contained
+func (b *Box[T]) Contains(point ...T) bool {
+	if len(point) != len(b.axes) {
+		return false
+	}
+	for i, axis := range b.axes {
+		if !axis.Contains(point[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsEmpty returns true if any axis of this box is empty
+func (b *Box[T]) IsEmpty() bool {
+	for _, axis := range b.axes {
+		if compareCut(compareBase[T], baseLowerCut(axis), baseUpperCut(axis)) >= 0 {
+			return true
+		}
+	}
+	return len(b.axes) == 0
+}
+
+// Intersect returns the box of the points in both this box and other, it may be empty
+func (b *Box[T]) Intersect(other *Box[T]) (*Box[T], error) {
+	if len(b.axes) != len(other.axes) {
+		return nil, BoxDimensionErr
+	}
+	axes := make([]*BaseInterval[T], len(b.axes))
+	for i, axis := range b.axes {
+		lower, upper := baseLowerCut(axis), baseUpperCut(axis)
+		if l := baseLowerCut(other.axes[i]); compareCut(compareBase[T], l, lower) > 0 {
+			lower = l
+		}
+		if u := baseUpperCut(other.axes[i]); compareCut(compareBase[T], u, upper) < 0 {
+			upper = u
+		}
+		axes[i] = newBaseIntervalCuts(lower, upper)
+	}
+	return NewBox(axes...), nil
+}
+
+// Overlaps returns true if this box and other have a point in common
+func (b *Box[T]) Overlaps(other *Box[T]) bool {
+	i, err := b.Intersect(other)
+	return err == nil && !i.IsEmpty()
+}
+
+// Union returns the bounding box of this box and other, an empty box adds nothing to the other one
+func (b *Box[T]) Union(other *Box[T]) (*Box[T], error) {
+	if len(b.axes) != len(other.axes) {
+		return nil, BoxDimensionErr
+	}
+	switch {
+	case b.IsEmpty():
+		return NewBox(append([]*BaseInterval[T]{}, other.axes...)...), nil
+	case other.IsEmpty():
+		return NewBox(append([]*BaseInterval[T]{}, b.axes...)...), nil
+	}
+	axes := make([]*BaseInterval[T], len(b.axes))
+	for i, axis := range b.axes {
+		lower, upper := baseLowerCut(axis), baseUpperCut(axis)
+		if l := baseLowerCut(other.axes[i]); compareCut(compareBase[T], l, lower) < 0 {
+			lower = l
+		}
+		if u := baseUpperCut(other.axes[i]); compareCut(compareBase[T], u, upper) > 0 {
+			upper = u
+		}
+		axes[i] = newBaseIntervalCuts(lower, upper)
+	}
+	return NewBox(axes...), nil
+}
+
+// Volume returns the product of the axis widths, 0 for an empty box
+func (b *Box[T]) Volume() float64 {
+	if b.IsEmpty() {
+		return 0
+	}
+	v := 1.0
+	for _, axis := range b.axes {
+		l, r := axis.ends()
+		v *= float64(r) - float64(l)
+	}
+	return v
+}
+
+// String returns a readable string of this box like "[0,1)x[2,5]"
+func (b *Box[T]) String() string {
+	strs := make([]string, 0, len(b.axes))
+	for _, axis := range b.axes {
+		strs = append(strs, axis.String())
+	}
+	return strings.Join(strs, BoxSpacer)
+}
+
+func baseLowerCut[T baseSortable](bi *BaseInterval[T]) cut[T] {
+	return bi.lower.lowerCut()
+}
+
+func baseUpperCut[T baseSortable](bi *BaseInterval[T]) cut[T] {
+	return bi.upper.upperCut()
+}
@@ -0,0 +1,79 @@
+package interval
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseBox(t *testing.T) {
+	tests := []struct {
+		name    string
+		str     string
+		want    *Box[float64]
+		wantErr bool
+	}{
+		{
+			name: "2d",
+			str:  "[0,1)x[2,5]",
+			want: NewBox(NewBaseInterval[float64](0, 1, ClosedOpen), NewBaseInterval[float64](2, 5, Closed)),
+		},
+		{
+			name: "3dSpaces",
+			str:  " (0, 1) × [2, 5] X (-1.5,0] ",
+			want: NewBox(NewBaseInterval[float64](0, 1, Open), NewBaseInterval[float64](2, 5, Closed), NewBaseInterval[float64](-1.5, 0, OpenClosed)),
+		},
+		{name: "1d", str: "[0,1]", want: NewBox(NewBaseInterval[float64](0, 1, Closed))},
+		{name: "separator", str: "[0,1)+[2,5]", wantErr: true},
+		{name: "trailing", str: "[0,1)x", wantErr: true},
+		{name: "value", str: "[0,a)x[2,5]", wantErr: true},
+		{name: "empty", str: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBox(tt.str)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseBox() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseBox() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBox(t *testing.T) {
+	b1, _ := ParseBox("[0,2)x[0,2]")
+	b2, _ := ParseBox("[1,3]x(2,4)")
+	b3, _ := ParseBox("[1,3]x[2,4)")
+
+	if !b1.Contains(0, 2) || b1.Contains(2, 0) || b1.Contains(1) {
+		t.Errorf("Contains() respects per axis ends and dimensions")
+	}
+	if b1.Overlaps(b2) {
+		t.Errorf("Overlaps() = true, want false for (2,4) against [0,2]")
+	}
+	i, err := b1.Intersect(b3)
+	if err != nil || i.String() != "[1,2)x[2,2]" || i.IsEmpty() || i.Volume() != 0 {
+		t.Errorf("Intersect() = %v, %v", i, err)
+	}
+	u, err := b1.Union(b2)
+	if err != nil || u.String() != "[0,3]x[0,4)" || u.Volume() != 12 {
+		t.Errorf("Union() = %v, %v", u, err)
+	}
+	empty, _ := ParseBox("[5,3]x[-10,10]")
+	for _, u := range []func() (*Box[float64], error){
+		func() (*Box[float64], error) { return empty.Union(b1) },
+		func() (*Box[float64], error) { return b1.Union(empty) },
+	} {
+		if got, err := u(); err != nil || got.String() != b1.String() || got.Contains(1, 5) {
+			t.Errorf("Union() with an empty box = %v, %v", got, err)
+		}
+	}
+	if _, err := b1.Union(NewBox(NewBaseInterval[float64](0, 1))); err != BoxDimensionErr {
+		t.Errorf("Union() error = %v, want %v", err, BoxDimensionErr)
+	}
+	if v := NewBox(NewBaseInterval(0, 3), NewBaseInterval(0, 3, Open)).Volume(); v != 9 {
+		t.Errorf("Volume() = %v, want 9", v)
+	}
+}
@@ -0,0 +1,83 @@
+package interval
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// CmpInterval is an interval of values ordered by a comparison function, for types such as big.Int which do not
+// implement SortComparable. The comparison returns a negative number, zero or a positive number when a is less
+// than, equal to or greater than b
+type CmpInterval[T any] struct {
+	bounds[T]
+	cmp func(a, b T) int
+}
+
+// NewCmpInterval return a new CmpInterval ordered by cmp, a method expression like (*big.Int).Cmp can be used
+func NewCmpInterval[T any](cmp func(a, b T) int, left, right T, openCloseType ...OpenClosedType) *CmpInterval[T] {
+	return &CmpInterval[T]{bounds: newBounds(left, right, openCloseType), cmp: cmp}
+}
+
+// NewCmpIntervalFromBounds return a new CmpInterval ordered by cmp between the given bounds, which may be Unbounded
+func NewCmpIntervalFromBounds[T any](cmp func(a, b T) int, lower, upper Bound[T]) *CmpInterval[T] {
+	return &CmpInterval[T]{bounds: bounds[T]{lower: lower, upper: upper}, cmp: cmp}
+}
+
+func newCmpIntervalCuts[T any](cmp func(a, b T) int, lower, upper cut[T]) *CmpInterval[T] {
+	return NewCmpIntervalFromBounds(cmp, lowerBound(lower), upperBound(upper))
+}
+
+// NewOrderedInterval return a new CmpInterval ordered by cmp.Compare
+func NewOrderedInterval[T cmp.Ordered](left, right T, openCloseType ...OpenClosedType) *CmpInterval[T] {
+	return NewCmpInterval(cmp.Compare[T], left, right, openCloseType...)
+}
+
+// NewComparableInterval return a new CmpInterval ordered by the CompareTo method of T
+func NewComparableInterval[T SortComparable[T]](left, right T, openCloseType ...OpenClosedType) *CmpInterval[T] {
+	return NewCmpInterval(Compare[T], left, right, openCloseType...)
+}
+
+// ParseCmpInterval parse str to interval, the values are parsed by parse and ordered by cmp,
+// the infinity tokens are parsed to Unbounded ends
+func ParseCmpInterval[T any](intervalStr string, parse func(string) (T, error), cmp func(a, b T) int) (i *CmpInterval[T], err error) {
+	return ParseCmpIntervalWith(&DefaultGrammar, intervalStr, parse, cmp)
+}
+
+// ParseCmpIntervalWith is ParseCmpInterval with the given grammar
+func ParseCmpIntervalWith[T any](g *Grammar, intervalStr string, parse func(string) (T, error), cmp func(a, b T) int) (i *CmpInterval[T], err error) {
+	var openClosedType OpenClosedType
+	var lv, rv string
+	if openClosedType, lv, rv, err = g.blowUp(intervalStr); err != nil {
+		return
+	}
+	var lower, upper Bound[T]
+	if lower, upper, err = parseBounds(g, openClosedType, lv, rv, parse); err != nil {
+		return nil, err
+	}
+	return NewCmpIntervalFromBounds(cmp, lower, upper), nil
+}
+
+// Left returns the left value of this interval, or the zero value of T if it is Unbounded
+func (ci *CmpInterval[T]) Left() T {
+	return ci.lower.value
+}
+
+// Right returns the right value of this interval, or the zero value of T if it is Unbounded
+func (ci *CmpInterval[T]) Right() T {
+	return ci.upper.value
+}
+
+// Cmp returns the comparison function of this interval
+func (ci *CmpInterval[T]) Cmp() func(a, b T) int {
+	return ci.cmp
+}
+
+// Contains returns true if the given element is in this interval
+func (ci *CmpInterval[T]) Contains(e T) bool {
+	return ci.contains(ci.cmp, e)
+}
+
+// String returns a readable string of this interval, Unbounded ends are written as "-inf" and "+inf"
+func (ci *CmpInterval[T]) String() string {
+	return ci.string(func(v T) string { return fmt.Sprint(v) }, "-inf", "+inf")
+}
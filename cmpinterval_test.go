@@ -0,0 +1,56 @@
+package interval
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCmpInterval_Contains(t *testing.T) {
+	parseBig := func(s string) (*big.Int, error) {
+		i, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return nil, ValueStrErr
+		}
+		return i, nil
+	}
+	bi, err := ParseCmpInterval("[1, 100000000000000000000000)", parseBig, (*big.Int).Cmp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := bi.String(); got != "[1,100000000000000000000000)" {
+		t.Errorf("String() = %v", got)
+	}
+	big1, _ := parseBig("99999999999999999999999")
+	big2, _ := parseBig("100000000000000000000000")
+	tests := []struct {
+		name string
+		e    *big.Int
+		want bool
+	}{
+		{name: "left", e: big.NewInt(1), want: true},
+		{name: "inside", e: big1, want: true},
+		{name: "right", e: big2, want: false},
+		{name: "below", e: big.NewInt(0), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bi.Contains(tt.e); got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if _, err := ParseCmpInterval("[1, x)", parseBig, (*big.Int).Cmp); err != ValueStrErr {
+		t.Errorf("ParseCmpInterval() error = %v, want %v", err, ValueStrErr)
+	}
+}
+
+func TestNewOrderedInterval(t *testing.T) {
+	oi := NewOrderedInterval("a", "c", OpenClosed)
+	if oi.Contains("a") || !oi.Contains("b") || !oi.Contains("c") || oi.LeftClosed() || !oi.RightClosed() {
+		t.Errorf("Contains() of %v is wrong", oi)
+	}
+	ci := NewComparableInterval(testDay(1), testDay(3))
+	if !ci.Contains(1) || ci.Contains(3) || ci.String() != "[1,3)" {
+		t.Errorf("Contains() of %v is wrong", ci)
+	}
+}
@@ -21,9 +21,22 @@ const (
 
 	Spacer = ","
 	Space  = " "
+
+	UnionSpacer = " ∪ "
+	RangeDash   = "-"
+	BoxSpacer   = "x"
+	EmptySet    = "∅"
 )
 
 var (
-	OpenFlags   = map[string]struct{}{LeftOpen: {}, RightOpen: {}}
+	// OpenFlags are the open brackets of DefaultGrammar.
+	//
+	// Deprecated: the parsers no longer use it and it is wrong for other grammars, use the
+	// LeftOpen and RightOpen tokens of a Grammar instead.
+	OpenFlags = map[string]struct{}{LeftOpen: {}, RightOpen: {}}
+	// ClosedFlags are the closed brackets of DefaultGrammar.
+	//
+	// Deprecated: the parsers no longer use it and it is wrong for other grammars, use the
+	// LeftClosed and RightClosed tokens of a Grammar instead.
 	ClosedFlags = map[string]struct{}{LeftClosed: {}, RightClosed: {}}
 )
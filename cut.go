@@ -0,0 +1,59 @@
+package interval
+
+import "time"
+
+// cut is a position on an ordered line which sits just below or just above value,
+// the endpoints of intervals are compared as cuts so that open and closed flags are respected.
+// A cut with inf -1 or 1 sits below or above every value, it is the cut of an Unbounded end
+type cut[P any] struct {
+	value P
+	above bool
+	inf   int8
+}
+
+// lowerCut returns the cut of a left endpoint
+func lowerCut[P any](value P, closed bool) cut[P] {
+	return cut[P]{value: value, above: !closed}
+}
+
+// upperCut returns the cut of a right endpoint
+func upperCut[P any](value P, closed bool) cut[P] {
+	return cut[P]{value: value, above: closed}
+}
+
+// compareCut compares two cuts with the given value compare function
+func compareCut[P any](cmp func(a, b P) int, c1, c2 cut[P]) int {
+	if c1.inf != 0 || c2.inf != 0 {
+		return int(c1.inf) - int(c2.inf)
+	}
+	if c := cmp(c1.value, c2.value); c != 0 {
+		return c
+	}
+	switch {
+	case c1.above == c2.above:
+		return 0
+	case c1.above:
+		return 1
+	}
+	return -1
+}
+
+func compareBase[T baseSortable](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareTime(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,247 @@
+package interval
+
+import (
+	"iter"
+)
+
+// integer is the set of basic integer types which are discrete
+type integer interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Discrete defines the successor and predecessor of a value, types implement it to opt in
+// the discrete functions of Interval
+type Discrete[T any] interface {
+	SortComparable[T]
+	// Next returns the successor of this value, ok is false if this is the maximum value
+	Next() (next T, ok bool)
+	// Prev returns the predecessor of this value, ok is false if this is the minimum value
+	Prev() (prev T, ok bool)
+	// Distance returns the number of successor steps from this value to other, other is never less than this value
+	Distance(other T) uint64
+}
+
+// discreteDomain holds the operations discrete algorithms need
+type discreteDomain[P any] struct {
+	cmp      func(a, b P) int
+	next     func(P) (P, bool)
+	prev     func(P) (P, bool)
+	distance func(a, b P) uint64
+}
+
+func integerDomain[T integer]() discreteDomain[T] {
+	return *numberDomain[T]()
+}
+
+// numberDomain returns the domain of the integer kinds, or nil for the float kinds which are continuous
+func numberDomain[T number]() *discreteDomain[T] {
+	// only a float kind has a half
+	if half := T(1) / 2; half != 0 {
+		return nil
+	}
+	return &discreteDomain[T]{
+		cmp: compareBase[T],
+		next: func(v T) (T, bool) {
+			n := v + 1
+			return n, n > v
+		},
+		prev: func(v T) (T, bool) {
+			p := v - 1
+			return p, p < v
+		},
+		distance: func(a, b T) uint64 {
+			// wraps correctly for signed types thanks to two's complement
+			return uint64(b) - uint64(a)
+		},
+	}
+}
+
+func discreteTypeDomain[T Discrete[T]]() discreteDomain[T] {
+	return discreteDomain[T]{
+		cmp:      Compare[T],
+		next:     func(v T) (T, bool) { return v.Next() },
+		prev:     func(v T) (T, bool) { return v.Prev() },
+		distance: func(a, b T) uint64 { return a.Distance(b) },
+	}
+}
+
+// members returns the first and the last member of the interval, ok is false if it is empty
+func (d discreteDomain[P]) members(left, right P, openClosedType OpenClosedType) (first, last P, ok bool) {
+	first, last = left, right
+	if openClosedType&ClosedOpen != ClosedOpen {
+		if first, ok = d.next(left); !ok {
+			return
+		}
+	}
+	if openClosedType&OpenClosed != OpenClosed {
+		if last, ok = d.prev(right); !ok {
+			return
+		}
+	}
+	return first, last, d.cmp(first, last) <= 0
+}
+
+// canonical returns the ClosedOpen form of the interval,
+// the right end stays closed if the last member has no successor
+func (d discreteDomain[P]) canonical(left, right P, openClosedType OpenClosedType) (P, P, OpenClosedType) {
+	first, last, ok := d.members(left, right, openClosedType)
+	if !ok {
+		return left, left, ClosedOpen
+	}
+	if next, ok := d.next(last); ok {
+		return first, next, ClosedOpen
+	}
+	return first, last, Closed
+}
+
+// canonicalBounds returns the ClosedOpen form of bounds which may be Unbounded, an Unbounded end stays Unbounded
+// and the right end stays closed if the last member has no successor, ok is false if it is empty
+func (d discreteDomain[P]) canonicalBounds(b bounds[P]) (c bounds[P], ok bool) {
+	c = b
+	if c.lower.kind == Excluded {
+		first, ok := d.next(c.lower.value)
+		if !ok {
+			return c, false
+		}
+		c.lower = IncludedBound(first)
+	}
+	last := c.upper.value
+	switch c.upper.kind {
+	case Unbounded:
+		return c, true
+	case Excluded:
+		if last, ok = d.prev(c.upper.value); !ok {
+			return c, false
+		}
+	}
+	if c.lower.kind != Unbounded && d.cmp(c.lower.value, last) > 0 {
+		return c, false
+	}
+	c.upper = IncludedBound(last)
+	if next, ok := d.next(last); ok {
+		c.upper = ExcludedBound(next)
+	}
+	return c, true
+}
+
+// cardinality returns the number of members, saturating at the maximum uint64
+func (d discreteDomain[P]) cardinality(left, right P, openClosedType OpenClosedType) uint64 {
+	first, last, ok := d.members(left, right, openClosedType)
+	if !ok {
+		return 0
+	}
+	n := d.distance(first, last)
+	if n+1 == 0 {
+		return n
+	}
+	return n + 1
+}
+
+func (d discreteDomain[P]) equal(l1, r1 P, t1 OpenClosedType, l2, r2 P, t2 OpenClosedType) bool {
+	f1, e1, ok1 := d.members(l1, r1, t1)
+	f2, e2, ok2 := d.members(l2, r2, t2)
+	if !ok1 || !ok2 {
+		return ok1 == ok2
+	}
+	return d.cmp(f1, f2) == 0 && d.cmp(e1, e2) == 0
+}
+
+func (d discreteDomain[P]) each(left, right P, openClosedType OpenClosedType) iter.Seq[P] {
+	return func(yield func(P) bool) {
+		first, last, ok := d.members(left, right, openClosedType)
+		if !ok {
+			return
+		}
+		for v := first; yield(v) && d.cmp(v, last) < 0; {
+			v, _ = d.next(v)
+		}
+	}
+}
+
+// Canonical returns the ClosedOpen form of an integer interval, so "[1,3]", "(0,4)" and "[1,4)" are all "[1,4)".
+// An empty interval becomes [left,left), and the right end stays closed if it is the maximum value of T
+func Canonical[T integer](bi *BaseInterval[T]) *BaseInterval[T] {
+	l, r, t := integerDomain[T]().canonical(bi.values())
+	return NewBaseInterval[T](l, r, t)
+}
+
+// Cardinality returns the number of integers in the interval, saturating at the maximum uint64
+func Cardinality[T integer](bi *BaseInterval[T]) uint64 {
+	return integerDomain[T]().cardinality(bi.values())
+}
+
+// Equal returns true if the two integer intervals contain the same members
+func Equal[T integer](bi1, bi2 *BaseInterval[T]) bool {
+	l1, r1, t1 := bi1.values()
+	l2, r2, t2 := bi2.values()
+	return integerDomain[T]().equal(l1, r1, t1, l2, r2, t2)
+}
+
+// Members returns an iterator over the integers in the interval in ascending order
+func Members[T integer](bi *BaseInterval[T]) iter.Seq[T] {
+	return integerDomain[T]().each(bi.values())
+}
+
+// CanonicalDiscrete returns the ClosedOpen form of a discrete interval, see Canonical.
+// Unbounded ends stay Unbounded, an empty interval with an Unbounded end becomes [v,v) of its bounded value v
+func CanonicalDiscrete[T Discrete[T]](i *Interval[T]) *Interval[T] {
+	d := discreteTypeDomain[T]()
+	if i.isBounded() {
+		l, r, t := d.canonical(i.values())
+		return NewInterval[T](l, r, t)
+	}
+	c, ok := d.canonicalBounds(i.bounds)
+	if !ok {
+		v := i.lower.value
+		if i.lower.kind == Unbounded {
+			v = i.upper.value
+		}
+		return NewInterval[T](v, v, ClosedOpen)
+	}
+	return &Interval[T]{bounds: c}
+}
+
+// CardinalityDiscrete returns the number of members in a discrete interval, saturating at the maximum uint64.
+// ok is false if the interval has an Unbounded end and is not empty, Discrete types have no known extremes to count to
+func CardinalityDiscrete[T Discrete[T]](i *Interval[T]) (n uint64, ok bool) {
+	d := discreteTypeDomain[T]()
+	if i.isBounded() {
+		return d.cardinality(i.values()), true
+	}
+	_, nonEmpty := d.canonicalBounds(i.bounds)
+	return 0, !nonEmpty
+}
+
+// EqualDiscrete returns true if the two discrete intervals contain the same members
+func EqualDiscrete[T Discrete[T]](i1, i2 *Interval[T]) bool {
+	d := discreteTypeDomain[T]()
+	if i1.isBounded() && i2.isBounded() {
+		l1, r1, t1 := i1.values()
+		l2, r2, t2 := i2.values()
+		return d.equal(l1, r1, t1, l2, r2, t2)
+	}
+	c1, ok1 := d.canonicalBounds(i1.bounds)
+	c2, ok2 := d.canonicalBounds(i2.bounds)
+	if !ok1 || !ok2 {
+		return ok1 == ok2
+	}
+	return compareLower(d.cmp, c1.lower, c2.lower) == 0 && compareUpper(d.cmp, c1.upper, c2.upper) == 0
+}
+
+// MembersDiscrete returns an iterator over the members of a discrete interval in ascending order,
+// nothing is yielded if the left end is Unbounded and an Unbounded right end runs up to the last successor
+func MembersDiscrete[T Discrete[T]](i *Interval[T]) iter.Seq[T] {
+	d := discreteTypeDomain[T]()
+	if i.isBounded() {
+		return d.each(i.values())
+	}
+	return func(yield func(T) bool) {
+		c, ok := d.canonicalBounds(i.bounds)
+		if !ok || c.lower.kind == Unbounded {
+			return
+		}
+		for v := c.lower.value; ok && yield(v); {
+			v, ok = d.next(v)
+		}
+	}
+}
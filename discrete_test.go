@@ -0,0 +1,153 @@
+package interval
+
+import (
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestCanonical(t *testing.T) {
+	tests := []struct {
+		name string
+		bi   *BaseInterval[int64]
+		want *BaseInterval[int64]
+	}{
+		{name: "closed", bi: NewBaseInterval[int64](1, 3, Closed), want: NewBaseInterval[int64](1, 4, ClosedOpen)},
+		{name: "open", bi: NewBaseInterval[int64](0, 4, Open), want: NewBaseInterval[int64](1, 4, ClosedOpen)},
+		{name: "closedOpen", bi: NewBaseInterval[int64](1, 4, ClosedOpen), want: NewBaseInterval[int64](1, 4, ClosedOpen)},
+		{name: "openClosed", bi: NewBaseInterval[int64](0, 3, OpenClosed), want: NewBaseInterval[int64](1, 4, ClosedOpen)},
+		{name: "empty", bi: NewBaseInterval[int64](1, 2, Open), want: NewBaseInterval[int64](1, 1, ClosedOpen)},
+		{name: "max", bi: NewBaseInterval[int64](1, math.MaxInt64, Closed), want: NewBaseInterval[int64](1, math.MaxInt64, Closed)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Canonical(tt.bi); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Canonical() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCardinality(t *testing.T) {
+	tests := []struct {
+		name string
+		bi   *BaseInterval[int8]
+		want uint64
+	}{
+		{name: "closed", bi: NewBaseInterval[int8](1, 3, Closed), want: 3},
+		{name: "open", bi: NewBaseInterval[int8](0, 4, Open), want: 3},
+		{name: "empty", bi: NewBaseInterval[int8](1, 1, ClosedOpen), want: 0},
+		{name: "inverted", bi: NewBaseInterval[int8](3, 1, Closed), want: 0},
+		{name: "full", bi: NewBaseInterval[int8](math.MinInt8, math.MaxInt8, Closed), want: 256},
+		{name: "openMax", bi: NewBaseInterval[int8](math.MaxInt8, math.MaxInt8, OpenClosed), want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Cardinality(tt.bi); got != tt.want {
+				t.Errorf("Cardinality() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if got := Cardinality(NewBaseInterval[uint64](0, math.MaxUint64, Closed)); got != math.MaxUint64 {
+		t.Errorf("Cardinality() = %v, want saturated", got)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	i1, _ := ParseIntInterval("[1,3]")
+	i2, _ := ParseIntInterval("(0,4)")
+	i3, _ := ParseIntInterval("[1,4)")
+	i4, _ := ParseIntInterval("[1,4]")
+	if !Equal(i1, i2) || !Equal(i2, i3) {
+		t.Errorf("Equal() = false, want true")
+	}
+	if Equal(i1, i4) {
+		t.Errorf("Equal() = true, want false")
+	}
+	if !Equal(NewBaseInterval[int64](1, 2, Open), NewBaseInterval[int64](5, 5, ClosedOpen)) {
+		t.Errorf("Equal() of empty intervals = false, want true")
+	}
+}
+
+func TestMembers(t *testing.T) {
+	var got []uint8
+	Members(NewBaseInterval[uint8](250, 255, OpenClosed))(func(v uint8) bool {
+		got = append(got, v)
+		return true
+	})
+	if want := []uint8{251, 252, 253, 254, 255}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Members() = %v, want %v", got, want)
+	}
+
+	got = got[:0]
+	Members(NewBaseInterval[uint8](0, 100))(func(v uint8) bool {
+		got = append(got, v)
+		return len(got) < 2
+	})
+	if want := []uint8{0, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Members() = %v, want %v", got, want)
+	}
+}
+
+type testDay int
+
+func (d testDay) CompareTo(other testDay) int {
+	return int(d - other)
+}
+
+func (d testDay) Next() (testDay, bool) {
+	return d + 1, d < 6
+}
+
+func (d testDay) Prev() (testDay, bool) {
+	return d - 1, d > 0
+}
+
+func (d testDay) Distance(other testDay) uint64 {
+	return uint64(other - d)
+}
+
+func TestDiscreteInterval(t *testing.T) {
+	i := NewInterval[testDay](0, 6, OpenClosed)
+	if got, want := CanonicalDiscrete(i), NewInterval[testDay](1, 6, Closed); !reflect.DeepEqual(got, want) {
+		t.Errorf("CanonicalDiscrete() = %v, want %v", got, want)
+	}
+	if got, ok := CardinalityDiscrete(i); got != 6 || !ok {
+		t.Errorf("CardinalityDiscrete() = %v, want 6", got)
+	}
+	if !EqualDiscrete(i, NewInterval[testDay](1, 6, Closed)) {
+		t.Errorf("EqualDiscrete() = false, want true")
+	}
+	var got []testDay
+	MembersDiscrete(NewInterval[testDay](2, 5))(func(d testDay) bool {
+		got = append(got, d)
+		return true
+	})
+	if want := []testDay{2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MembersDiscrete() = %v, want %v", got, want)
+	}
+
+	// Unbounded ends have no zero value stand-in
+	tail := NewIntervalFromBounds(ExcludedBound[testDay](3), UnboundedBound[testDay]())
+	if got, want := CanonicalDiscrete(tail), NewIntervalFromBounds(IncludedBound[testDay](4), UnboundedBound[testDay]()); !reflect.DeepEqual(got, want) {
+		t.Errorf("CanonicalDiscrete() = %v, want %v", got, want)
+	}
+	if got, ok := CardinalityDiscrete(tail); ok {
+		t.Errorf("CardinalityDiscrete() = %v, %v", got, ok)
+	}
+	if got := slices.Collect(MembersDiscrete(tail)); !reflect.DeepEqual(got, []testDay{4, 5, 6}) {
+		t.Errorf("MembersDiscrete() = %v", got)
+	}
+	head := NewIntervalFromBounds(UnboundedBound[testDay](), ExcludedBound[testDay](2))
+	if got := slices.Collect(MembersDiscrete(head)); len(got) != 0 {
+		t.Errorf("MembersDiscrete() = %v", got)
+	}
+	if EqualDiscrete(head, NewInterval[testDay](0, 2)) || !EqualDiscrete(head, NewIntervalFromBounds(UnboundedBound[testDay](), IncludedBound[testDay](1))) {
+		t.Errorf("EqualDiscrete() of %v is wrong", head)
+	}
+	empty := NewIntervalFromBounds(UnboundedBound[testDay](), ExcludedBound[testDay](0))
+	if got, ok := CardinalityDiscrete(empty); got != 0 || !ok || !EqualDiscrete(empty, NewInterval[testDay](3, 3)) {
+		t.Errorf("CardinalityDiscrete(%v) = %v, %v", empty, got, ok)
+	}
+}
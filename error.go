@@ -1,9 +1,40 @@
 package interval
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
-	ParseTooShortErr  = errors.New("parse interval string err: str too short")
-	OpenClosedFlagErr = errors.New("parse interval string err: open closed flag err")
-	ValueStrErr       = errors.New("parse interval string err: value err")
+	ParseTooShortErr           = errors.New("parse interval string err: str too short")
+	OpenClosedFlagErr          = errors.New("parse interval string err: open closed flag err")
+	ValueStrErr                = errors.New("parse interval string err: value err")
+	IPFamilyErr                = errors.New("parse interval string err: ip family mismatch")
+	SemVerErr                  = errors.New("parse semver err: invalid version")
+	ConstraintErr              = errors.New("parse semver constraint err: invalid constraint")
+	RangeListErr               = errors.New("parse range list err: invalid range")
+	BoxDimensionErr            = errors.New("box err: dimension mismatch")
+	GenomicRecordErr           = errors.New("parse genomic record err: invalid record")
+	HTTPRangeErr               = errors.New("parse http range err: invalid range")
+	HTTPRangeNotSatisfiableErr = errors.New("parse http range err: range not satisfiable")
+	ValueRangeErr              = errors.New("parse interval string err: value out of range")
+	ClampOpenEndErr            = errors.New("clamp err: open end has no nearest value")
+	ClampEmptyErr              = errors.New("clamp err: empty interval")
+	SetExprErr                 = errors.New("parse set expression err: invalid expression")
 )
+
+// ValueRangeError is returned when a value does not fit the type of the interval, it matches ValueRangeErr with errors.Is
+type ValueRangeError struct {
+	// Value is the value string
+	Value string
+	// Type is the name of the interval type
+	Type string
+}
+
+func (e *ValueRangeError) Error() string {
+	return fmt.Sprintf("%v: %q overflows %v", ValueRangeErr, e.Value, e.Type)
+}
+
+func (e *ValueRangeError) Unwrap() error {
+	return ValueRangeErr
+}
@@ -0,0 +1,219 @@
+package interval
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// The operators of set expressions, each one has a Unicode and an ASCII token
+var (
+	unionTokens      = []string{"∪", "|"}
+	intersectTokens  = []string{"∩", "&"}
+	differenceTokens = []string{"\\", "∖"}
+	complementTokens = []string{"∁", "~"}
+)
+
+// ParseSetExpr parses a set expression like "[18,25) ∪ [60,+inf) \ {65}" to a normalized set.
+//
+// The operands are intervals, singleton sets like "{65}" or "{1,3}" and the empty set "∅".
+// The operators are the union "∪" or "|", the intersection "∩" or "&", the difference "\" and the prefix
+// complement "∁" or "~". The complement binds tightest, then the intersection, the union and the difference
+// share the lowest precedence and are evaluated from left to right, parentheses group sub expressions.
+// Infinity tokens and infinite float values are Unbounded ends of intervals, they are not values of singleton sets,
+// and NaN is rejected. Sets of integer kinds are discrete, so "[1,2] ∪ [3,4]" is "[1,4]".
+// Every error matches SetExprErr with errors.Is and wraps the error of the invalid operand if there is one
+func ParseSetExpr[T number](str string) (*BaseIntervalSet[T], error) {
+	return ParseSetExprWith[T](&DefaultGrammar, str)
+}
+
+// ParseSetExprWith is ParseSetExpr with the given grammar for the intervals and values
+func ParseSetExprWith[T number](g *Grammar, str string) (*BaseIntervalSet[T], error) {
+	p := &setExprParser[T]{g: g, str: str}
+	s, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if p.skipSpace(); p.pos < len(p.str) {
+		return nil, p.err()
+	}
+	return &BaseIntervalSet[T]{s: s}, nil
+}
+
+// setExprParser is a recursive descent parser of set expressions
+type setExprParser[T number] struct {
+	g   *Grammar
+	str string
+	pos int
+}
+
+func (p *setExprParser[T]) empty() *rangeSet[T] {
+	return &rangeSet[T]{cmp: compareBase[T], discrete: numberDomain[T]()}
+}
+
+func (p *setExprParser[T]) err() error {
+	return fmt.Errorf("%w: %q at offset %d", SetExprErr, p.str[p.pos:], p.pos)
+}
+
+// operandErr returns the error of the operand str at offset pos, err is the error of its parse if any
+func (p *setExprParser[T]) operandErr(str string, pos int, err error) error {
+	if err == nil {
+		return fmt.Errorf("%w: %q at offset %d", SetExprErr, str, pos)
+	}
+	return fmt.Errorf("%w: %q at offset %d: %w", SetExprErr, str, pos, err)
+}
+
+func (p *setExprParser[T]) skipSpace() {
+	p.pos += len(p.str[p.pos:]) - len(strings.TrimLeftFunc(p.str[p.pos:], unicode.IsSpace))
+}
+
+// accept consumes one of the tokens if the rest of the expression starts with it
+func (p *setExprParser[T]) accept(tokens ...string) bool {
+	p.skipSpace()
+	for _, token := range tokens {
+		if strings.HasPrefix(p.str[p.pos:], token) {
+			p.pos += len(token)
+			return true
+		}
+	}
+	return false
+}
+
+// expr := term { ("∪" | "\") term }
+func (p *setExprParser[T]) expr() (*rangeSet[T], error) {
+	s, err := p.term()
+	for err == nil {
+		var o *rangeSet[T]
+		switch {
+		case p.accept(unionTokens...):
+			if o, err = p.term(); err == nil {
+				s = s.union(o)
+			}
+		case p.accept(differenceTokens...):
+			if o, err = p.term(); err == nil {
+				s = s.difference(o)
+			}
+		default:
+			return s, nil
+		}
+	}
+	return nil, err
+}
+
+// term := unary { "∩" unary }
+func (p *setExprParser[T]) term() (*rangeSet[T], error) {
+	s, err := p.unary()
+	for err == nil && p.accept(intersectTokens...) {
+		var o *rangeSet[T]
+		if o, err = p.unary(); err == nil {
+			s = s.intersect(o)
+		}
+	}
+	return s, err
+}
+
+// unary := "∁" unary | primary
+func (p *setExprParser[T]) unary() (*rangeSet[T], error) {
+	if !p.accept(complementTokens...) {
+		return p.primary()
+	}
+	s, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	return s.complement(), nil
+}
+
+// primary := interval | "{" values "}" | "∅" | "(" expr ")"
+func (p *setExprParser[T]) primary() (*rangeSet[T], error) {
+	p.skipSpace()
+	rest := p.str[p.pos:]
+	switch {
+	case p.accept(EmptySet):
+		return p.empty(), nil
+	case p.accept("{"):
+		return p.values()
+	case strings.HasPrefix(rest, p.g.LeftClosed) || strings.HasPrefix(rest, p.g.LeftOpen):
+		// "(" starts either an interval like "(1,2)" or a group like "((1,2) ∪ [3,4])"
+		if end := p.g.axisEnd(rest); end > 0 {
+			bi, err := ParseBaseIntervalWith[T](p.g, rest[:end])
+			if err == nil && (bi.Left() != bi.Left() || bi.Right() != bi.Right()) {
+				return nil, p.operandErr(rest[:end], p.pos, nil)
+			}
+			if err == nil {
+				p.pos += end
+				return p.empty().add(unboundInfinities(bi).cuts()), nil
+			}
+			if !strings.HasPrefix(rest, "(") {
+				return nil, p.operandErr(rest[:end], p.pos, err)
+			}
+		}
+	}
+	if !p.accept("(") {
+		return nil, p.err()
+	}
+	s, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.accept(")") {
+		return nil, p.err()
+	}
+	return s, nil
+}
+
+// values parses the values of a singleton set up to the closing brace
+func (p *setExprParser[T]) values() (*rangeSet[T], error) {
+	end := strings.Index(p.str[p.pos:], "}")
+	if end < 0 {
+		return nil, p.err()
+	}
+	s := p.empty()
+	pos := p.pos
+	for _, str := range strings.Split(p.str[p.pos:p.pos+end], p.g.Separator) {
+		v, err := parseBaseValue[T](p.g, strings.TrimSpace(str))
+		if err != nil {
+			return nil, p.operandErr(str, pos, err)
+		}
+		// NaN and the infinities are no members
+		if v-v != 0 {
+			return nil, p.operandErr(str, pos, nil)
+		}
+		s = s.add(lowerCut(v, true), upperCut(v, true))
+		pos += len(str) + len(p.g.Separator)
+	}
+	p.pos += end + 1
+	return s, nil
+}
+
+// unboundInfinities returns bi with its infinite float values as Unbounded ends
+func unboundInfinities[T number](bi *BaseInterval[T]) *BaseInterval[T] {
+	lower, upper := bi.lower, bi.upper
+	if v, ok := lower.Value(); ok && v < 0 && v-v != 0 {
+		lower = UnboundedBound[T]()
+	}
+	if v, ok := upper.Value(); ok && v > 0 && v-v != 0 {
+		upper = UnboundedBound[T]()
+	}
+	return NewBaseIntervalFromBounds(lower, upper)
+}
+
+// Expression returns the canonical set expression of this set, like "[18,25) ∪ [60,65) ∪ (65,+inf)",
+// closed intervals of one value are written as singleton sets like "{65}" and the empty set as "∅".
+// ParseSetExpr parses it back to an equal set
+func (set *BaseIntervalSet[T]) Expression() string {
+	if set.IsEmpty() {
+		return EmptySet
+	}
+	strs := make([]string, 0, len(set.s.spans))
+	for _, bi := range set.Intervals() {
+		l, lok := bi.lower.Value()
+		r, rok := bi.upper.Value()
+		if lok && rok && bi.OpenClosedType() == Closed && compareBase(l, r) == 0 {
+			strs = append(strs, "{"+fmt.Sprint(l)+"}")
+			continue
+		}
+		strs = append(strs, bi.String())
+	}
+	return strings.Join(strs, UnionSpacer)
+}
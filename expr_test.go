@@ -0,0 +1,86 @@
+package interval
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestParseSetExpr(t *testing.T) {
+	tests := []struct {
+		expr    string
+		want    string
+		wantErr bool
+	}{
+		{expr: "[18,25) ∪ [60,+inf) \\ {65}", want: "[18,24] ∪ [60,64] ∪ [66,+inf)"},
+		{expr: "[18,25) | [60,inf) \\ {65}", want: "[18,24] ∪ [60,64] ∪ [66,+inf)"},
+		{expr: "[1,2] ∪ [3,4] ∪ {5}", want: "[1,5]"},
+		{expr: "{7}", want: "{7}"},
+		{expr: "{1,3, 5}", want: "{1} ∪ {3} ∪ {5}"},
+		{expr: "∁[0,10)", want: "(-inf,-1] ∪ [10,+inf)"},
+		{expr: "~~[0,10)", want: "[0,9]"},
+		{expr: "[0,10] ∩ [5,20] ∪ [30,40]", want: "[5,10] ∪ [30,40]"},
+		{expr: "[0,10] ∩ ([5,20] ∪ [30,40])", want: "[5,10]"},
+		{expr: "((0,3) ∪ (5,8)) & [2,6]", want: "{2} ∪ {6}"},
+		{expr: "[0,10] \\ [0,10]", want: "∅"},
+		{expr: "∅ ∪ {1}", want: "{1}"},
+		{expr: "[0,10] ∪", wantErr: true},
+		{expr: "[0,10] ∪ (1,2", wantErr: true},
+		{expr: "([0,10]", wantErr: true},
+		{expr: "{1", wantErr: true},
+		{expr: "[0,10] [2,3]", wantErr: true},
+	}
+	for _, tt := range tests {
+		set, err := ParseSetExpr[int](tt.expr)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSetExpr(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if got := set.Expression(); got != tt.want {
+			t.Errorf("ParseSetExpr(%q) = %v, want %v", tt.expr, got, tt.want)
+		}
+		again, err := ParseSetExpr[int](set.Expression())
+		if err != nil || again.Expression() != set.Expression() {
+			t.Errorf("ParseSetExpr(%q) = %v, %v", set.Expression(), again, err)
+		}
+	}
+	for _, expr := range []string{"[0,10] $", "[a,2]", "[0,10] ∪ {1, x}", "(a,2)", "{+inf}"} {
+		if _, err := ParseSetExpr[int](expr); !errors.Is(err, SetExprErr) {
+			t.Errorf("ParseSetExpr(%q) err = %v", expr, err)
+		}
+	}
+	var numErr *strconv.NumError
+	if _, err := ParseSetExpr[int]("[0,10] ∪ {1, x}"); !errors.As(err, &numErr) || !strings.Contains(err.Error(), "offset 14") {
+		t.Errorf("ParseSetExpr() err = %v", err)
+	}
+}
+
+func TestParseSetExpr_Float(t *testing.T) {
+	set, err := ParseSetExpr[float64]("[18,25) ∪ [60,+inf) \\ {65}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := set.Expression(), "[18,25) ∪ [60,65) ∪ (65,+inf)"; got != want {
+		t.Errorf("Expression() = %v, want %v", got, want)
+	}
+	if !set.Contains(64.5) || set.Contains(65) || set.Contains(25) {
+		t.Errorf("%v has wrong members", set)
+	}
+	if got, want := set.Complement().Expression(), "(-inf,18) ∪ [25,60) ∪ {65}"; got != want {
+		t.Errorf("Complement() = %v, want %v", got, want)
+	}
+	for _, expr := range []string{"{NaN}", "{+inf}", "{1, -inf}", "[NaN,2]"} {
+		if _, err := ParseSetExpr[float64](expr); !errors.Is(err, SetExprErr) {
+			t.Errorf("ParseSetExpr(%q) err = %v", expr, err)
+		}
+	}
+	g := ISOGrammar
+	g.Separator, g.DecimalComma = ";", true
+	if set, err = ParseSetExprWith[float64](&g, "]0;1,5] ∪ {2,5; 3}"); err != nil || set.Expression() != "(0,1.5] ∪ {2.5} ∪ {3}" {
+		t.Errorf("ParseSetExprWith() = %v, %v", set, err)
+	}
+}
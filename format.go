@@ -0,0 +1,337 @@
+package interval
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The verbs of the fmt.Formatter implementation of the interval types, %v and %s print the String form
+const (
+	// CompactVerb prints "[1,2)", a closed interval of one value like "{1}" and an empty interval like "∅"
+	CompactVerb = 'c'
+	// UnicodeVerb prints math notation like "[1, ∞)"
+	UnicodeVerb = 'u'
+	// LaTeXVerb prints LaTeX like "\left[1, \infty\right)"
+	LaTeXVerb = 'l'
+)
+
+// ValueFormat holds per call value formatters, a nil formatter keeps the default one.
+// A precision like "%.2u" formats float values with that many decimals unless Float is set
+type ValueFormat struct {
+	Float func(f float64) string
+	Time  func(t time.Time) string
+}
+
+// formatting is the view of an interval used by the fmt.Formatter implementations
+type formatting struct {
+	// left and right are nil for Unbounded ends
+	left           any
+	right          any
+	openClosedType OpenClosedType
+	// cmp is the comparison of left and right, it is only used if both are present
+	cmp int
+	// nullable is true for NullableTimeInterval, whose Unbounded ends are nil values written as NullFlag
+	nullable bool
+	// constructor and boundsConstructor are the Go syntax of the constructors like "interval.NewBaseInterval[int]",
+	// typeName is the Go syntax of the value type
+	constructor       string
+	boundsConstructor string
+	typeName          string
+	vf                ValueFormat
+}
+
+// newFormatting returns the formatting of the bounds b
+func newFormatting[T any](b *bounds[T], cmp func(a, b T) int, vf ValueFormat) *formatting {
+	fm := &formatting{openClosedType: b.OpenClosedType(), vf: vf}
+	l, lok := b.lower.Value()
+	r, rok := b.upper.Value()
+	if lok {
+		fm.left = l
+	}
+	if rok {
+		fm.right = r
+	}
+	if lok && rok {
+		fm.cmp = cmp(l, r)
+	}
+	return fm
+}
+
+// Format implements fmt.Formatter
+func (bi *BaseInterval[T]) Format(f fmt.State, verb rune) {
+	bi.formatting(ValueFormat{}).format(f, verb)
+}
+
+// Formatted returns a fmt.Formatter of this interval which formats the values with vf
+func (bi *BaseInterval[T]) Formatted(vf ValueFormat) fmt.Formatter {
+	return bi.formatting(vf)
+}
+
+func (bi *BaseInterval[T]) formatting(vf ValueFormat) *formatting {
+	fm := newFormatting(&bi.bounds, compareBase[T], vf)
+	fm.typeName = fmt.Sprintf("%T", *new(T))
+	fm.constructor = "interval.NewBaseInterval[" + fm.typeName + "]"
+	fm.boundsConstructor = "interval.NewBaseIntervalFromBounds[" + fm.typeName + "]"
+	return fm
+}
+
+// Format implements fmt.Formatter
+func (i *Interval[T]) Format(f fmt.State, verb rune) {
+	i.formatting(ValueFormat{}).format(f, verb)
+}
+
+// Formatted returns a fmt.Formatter of this interval which formats the values with vf
+func (i *Interval[T]) Formatted(vf ValueFormat) fmt.Formatter {
+	return i.formatting(vf)
+}
+
+func (i *Interval[T]) formatting(vf ValueFormat) *formatting {
+	fm := newFormatting(&i.bounds, Compare[T], vf)
+	fm.typeName = fmt.Sprintf("%T", *new(T))
+	fm.constructor = "interval.NewInterval[" + fm.typeName + "]"
+	fm.boundsConstructor = "interval.NewIntervalFromBounds[" + fm.typeName + "]"
+	return fm
+}
+
+// Format implements fmt.Formatter, times are printed in RFC3339.
+// The Go syntax of %#v prints times outside UTC and Local as the same instants in UTC
+func (ti *TimeInterval) Format(f fmt.State, verb rune) {
+	ti.formatting(ValueFormat{}).format(f, verb)
+}
+
+// Formatted returns a fmt.Formatter of this interval which formats the values with vf
+func (ti *TimeInterval) Formatted(vf ValueFormat) fmt.Formatter {
+	return ti.formatting(vf)
+}
+
+func (ti *TimeInterval) formatting(vf ValueFormat) *formatting {
+	fm := newFormatting(&ti.bounds, compareTime, vf)
+	fm.typeName = "time.Time"
+	fm.constructor = "interval.NewTimeInterval"
+	fm.boundsConstructor = "interval.NewTimeIntervalFromBounds"
+	return fm
+}
+
+// Format implements fmt.Formatter, times are printed in RFC3339 and a missing value is printed as NullFlag,
+// or as an infinity by the Unicode and LaTeX verbs. The Go syntax of %#v prints times outside UTC and Local
+// as the same instants in UTC
+func (ti *NullableTimeInterval) Format(f fmt.State, verb rune) {
+	ti.formatting(ValueFormat{}).format(f, verb)
+}
+
+// Formatted returns a fmt.Formatter of this interval which formats the values with vf
+func (ti *NullableTimeInterval) Formatted(vf ValueFormat) fmt.Formatter {
+	return ti.formatting(vf)
+}
+
+func (ti *NullableTimeInterval) formatting(vf ValueFormat) *formatting {
+	fm := newFormatting(&ti.bounds, compareTime, vf)
+	fm.nullable = true
+	fm.constructor = "interval.NewNullableTimeInterval"
+	return fm
+}
+
+// Format implements fmt.Formatter
+func (fm *formatting) Format(f fmt.State, verb rune) {
+	fm.format(f, verb)
+}
+
+func (fm *formatting) format(f fmt.State, verb rune) {
+	var str string
+	switch verb {
+	case 'v':
+		if f.Flag('#') {
+			str = fm.goSyntax()
+		} else {
+			str = fm.notation(f, verb, LeftClosed, LeftOpen, Spacer, RightClosed, RightOpen)
+		}
+	case 's':
+		str = fm.notation(f, verb, LeftClosed, LeftOpen, Spacer, RightClosed, RightOpen)
+	case 'q':
+		str = strconv.Quote(fm.notation(f, verb, LeftClosed, LeftOpen, Spacer, RightClosed, RightOpen))
+	case CompactVerb:
+		switch {
+		case fm.isEmpty():
+			str = EmptySet
+		case fm.isSingleton():
+			str = "{" + fm.value(f, verb, fm.left, -1) + "}"
+		default:
+			str = fm.notation(f, verb, LeftClosed, LeftOpen, Spacer, RightClosed, RightOpen)
+		}
+	case UnicodeVerb:
+		if fm.isEmpty() {
+			str = EmptySet
+		} else {
+			str = fm.notation(f, verb, LeftClosed, LeftOpen, Spacer+Space, RightClosed, RightOpen)
+		}
+	case LaTeXVerb:
+		if fm.isEmpty() {
+			str = `\emptyset`
+		} else {
+			str = fm.notation(f, verb, `\left[`, `\left(`, Spacer+Space, `\right]`, `\right)`)
+		}
+	default:
+		str = fmt.Sprintf("%%!%c(interval=%s)", verb, fm.notation(f, 'v', LeftClosed, LeftOpen, Spacer, RightClosed, RightOpen))
+	}
+	pad(f, str)
+}
+
+// notation writes the interval with the given brackets and separator
+func (fm *formatting) notation(f fmt.State, verb rune, leftClosed, leftOpen, spacer, rightClosed, rightOpen string) string {
+	var sb strings.Builder
+	if fm.openClosedType&ClosedOpen == ClosedOpen {
+		sb.WriteString(leftClosed)
+	} else {
+		sb.WriteString(leftOpen)
+	}
+	sb.WriteString(fm.value(f, verb, fm.left, -1))
+	sb.WriteString(spacer)
+	sb.WriteString(fm.value(f, verb, fm.right, 1))
+	if fm.openClosedType&OpenClosed == OpenClosed {
+		sb.WriteString(rightClosed)
+	} else {
+		sb.WriteString(rightOpen)
+	}
+	return sb.String()
+}
+
+// value formats one value of the interval, side is -1 for the left value and 1 for the right value
+func (fm *formatting) value(f fmt.State, verb rune, v any, side int) string {
+	if v == nil {
+		def := "+inf"
+		switch {
+		case fm.nullable:
+			def = NullFlag
+		case side < 0:
+			def = "-inf"
+		}
+		return fm.infinity(verb, side, def)
+	}
+	if t, ok := v.(time.Time); ok {
+		if fm.vf.Time != nil {
+			return fm.vf.Time(t)
+		}
+		return t.Format(defaultTimeLayout)
+	}
+	rv := reflect.ValueOf(v)
+	if k := rv.Kind(); k != reflect.Float32 && k != reflect.Float64 {
+		return fmt.Sprint(v)
+	}
+	fv := rv.Float()
+	if math.IsInf(fv, 0) {
+		return fm.infinity(verb, int(math.Copysign(1, fv)), fmt.Sprint(v))
+	}
+	if fm.vf.Float != nil {
+		return fm.vf.Float(fv)
+	}
+	if prec, ok := f.Precision(); ok {
+		return strconv.FormatFloat(fv, 'f', prec, rv.Type().Bits())
+	}
+	return fmt.Sprint(v)
+}
+
+// infinity returns the infinity of sign in the notation of verb, or def if the notation has none
+func (fm *formatting) infinity(verb rune, sign int, def string) string {
+	inf := ""
+	switch verb {
+	case UnicodeVerb:
+		inf = "∞"
+	case LaTeXVerb:
+		inf = `\infty`
+	default:
+		return def
+	}
+	if sign < 0 {
+		return "-" + inf
+	}
+	return inf
+}
+
+func (fm *formatting) isEmpty() bool {
+	return fm.left != nil && fm.right != nil && (fm.cmp > 0 || (fm.cmp == 0 && fm.openClosedType != Closed))
+}
+
+func (fm *formatting) isSingleton() bool {
+	return fm.left != nil && fm.right != nil && fm.cmp == 0 && fm.openClosedType == Closed
+}
+
+// goSyntax returns the constructor call which builds the interval
+func (fm *formatting) goSyntax() string {
+	if !fm.nullable && (fm.left == nil || fm.right == nil) {
+		return fmt.Sprintf("%s(%s, %s)", fm.boundsConstructor, fm.goBound(fm.left, ClosedOpen), fm.goBound(fm.right, OpenClosed))
+	}
+	return fmt.Sprintf("%s(%s, %s, interval.%s)", fm.constructor, fm.goValue(fm.left), fm.goValue(fm.right),
+		openClosedTypeName(fm.openClosedType))
+}
+
+// goBound returns the Go syntax of the bound of v, closed is the flag of its side
+func (fm *formatting) goBound(v any, closed OpenClosedType) string {
+	switch {
+	case v == nil:
+		return "interval.UnboundedBound[" + fm.typeName + "]()"
+	case fm.openClosedType&closed == closed:
+		return "interval.IncludedBound[" + fm.typeName + "](" + fm.goValue(v) + ")"
+	}
+	return "interval.ExcludedBound[" + fm.typeName + "](" + fm.goValue(v) + ")"
+}
+
+func (fm *formatting) goValue(v any) string {
+	if fm.nullable {
+		if v == nil {
+			return "nil"
+		}
+		return fmt.Sprintf("&[]time.Time{%s}[0]", goTime(v.(time.Time)))
+	}
+	if t, ok := v.(time.Time); ok {
+		return goTime(t)
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Float32 || rv.Kind() == reflect.Float64 {
+		switch fv := rv.Float(); {
+		case math.IsInf(fv, 1):
+			return "math.Inf(1)"
+		case math.IsInf(fv, -1):
+			return "math.Inf(-1)"
+		case math.IsNaN(fv):
+			return "math.NaN()"
+		}
+	}
+	return fmt.Sprintf("%#v", v)
+}
+
+// goTime returns the Go syntax of t, a location other than UTC and Local has no Go syntax,
+// so the time is printed as the same instant in UTC
+func goTime(t time.Time) string {
+	if loc := t.Location(); loc != time.UTC && loc != time.Local {
+		t = t.UTC()
+	}
+	return fmt.Sprintf("%#v", t)
+}
+
+func openClosedTypeName(t OpenClosedType) string {
+	switch t {
+	case Open:
+		return "Open"
+	case OpenClosed:
+		return "OpenClosed"
+	case ClosedOpen:
+		return "ClosedOpen"
+	}
+	return "Closed"
+}
+
+// pad writes str to f with the width and the '-' flag of f
+func pad(f fmt.State, str string) {
+	width, ok := f.Width()
+	if n := width - len([]rune(str)); ok && n > 0 {
+		if f.Flag('-') {
+			str += strings.Repeat(Space, n)
+		} else {
+			str = strings.Repeat(Space, n) + str
+		}
+	}
+	_, _ = f.Write([]byte(str))
+}
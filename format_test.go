@@ -0,0 +1,85 @@
+package interval
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestBaseInterval_Format(t *testing.T) {
+	inf := NewBaseInterval(1.5, math.Inf(1), ClosedOpen)
+	tests := []struct {
+		name   string
+		format string
+		arg    any
+		want   string
+	}{
+		{name: "string", format: "%v", arg: inf, want: inf.String()},
+		{name: "unicode", format: "%u", arg: inf, want: "[1.5, ∞)"},
+		{name: "latex", format: "%l", arg: inf, want: `\left[1.5, \infty\right)`},
+		{name: "precision", format: "%.2u", arg: inf, want: "[1.50, ∞)"},
+		{name: "go", format: "%#v", arg: inf, want: "interval.NewBaseInterval[float64](1.5, math.Inf(1), interval.ClosedOpen)"},
+		{name: "go string", format: "%#v", arg: NewBaseInterval("a", "b", Open), want: `interval.NewBaseInterval[string]("a", "b", interval.Open)`},
+		{name: "compact", format: "%c", arg: NewBaseInterval(1, 2), want: "[1,2)"},
+		{name: "compact singleton", format: "%c", arg: NewBaseInterval(3, 3, Closed), want: "{3}"},
+		{name: "compact empty", format: "%c", arg: NewBaseInterval(3, 3, ClosedOpen), want: EmptySet},
+		{name: "latex empty", format: "%l", arg: NewBaseInterval(4, 3, Closed), want: `\emptyset`},
+		{name: "width", format: "%-7s|", arg: NewBaseInterval(1, 2), want: "[1,2)  |"},
+		{name: "quote", format: "%q", arg: NewBaseInterval(1, 2), want: `"[1,2)"`},
+		{name: "bad verb", format: "%d", arg: NewBaseInterval(1, 2), want: "%!d(interval=[1,2))"},
+		{name: "interval", format: "%#v", arg: NewInterval(testDay(1), testDay(2), Closed), want: "interval.NewInterval[interval.testDay](1, 2, interval.Closed)"},
+		{name: "interval unicode", format: "%u", arg: NewInterval(testDay(1), testDay(2), Closed), want: "[1, 2]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.arg); got != tt.want {
+				t.Errorf("Sprintf(%q) = %v, want %v", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeInterval_Format(t *testing.T) {
+	t1 := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC)
+	ti := NewTimeInterval(t1, t2)
+	if got, want := fmt.Sprintf("%v", ti), "[2023-01-02T00:00:00Z,2023-01-03T00:00:00Z)"; got != want {
+		t.Errorf("Sprintf() = %v, want %v", got, want)
+	}
+	date := ValueFormat{Time: func(t time.Time) string { return t.Format(time.DateOnly) }}
+	if got, want := fmt.Sprintf("%u", ti.Formatted(date)), "[2023-01-02, 2023-01-03)"; got != want {
+		t.Errorf("Sprintf() = %v, want %v", got, want)
+	}
+	if got, want := fmt.Sprintf("%#v", ti), "interval.NewTimeInterval(time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC), time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC), interval.ClosedOpen)"; got != want {
+		t.Errorf("Sprintf() = %v, want %v", got, want)
+	}
+
+	nti := NewNullableTimeInterval(&t1, nil, Open)
+	if got, want := fmt.Sprintf("%s", nti), "(2023-01-02T00:00:00Z,NULL)"; got != want {
+		t.Errorf("Sprintf() = %v, want %v", got, want)
+	}
+	if got, want := fmt.Sprintf("%l", nti.Formatted(date)), `\left(2023-01-02, \infty\right)`; got != want {
+		t.Errorf("Sprintf() = %v, want %v", got, want)
+	}
+	if got, want := fmt.Sprintf("%#v", nti), "interval.NewNullableTimeInterval(&[]time.Time{time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC)}[0], nil, interval.Open)"; got != want {
+		t.Errorf("Sprintf() = %v, want %v", got, want)
+	}
+
+	// a zone has no Go syntax, its times are printed in UTC
+	tokyo := time.FixedZone("JST", 9*60*60)
+	zoned := NewTimeInterval(t1.In(tokyo), t1.Add(time.Hour).In(tokyo))
+	if got, want := fmt.Sprintf("%#v", zoned), "interval.NewTimeInterval(time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC), time.Date(2023, time.January, 2, 1, 0, 0, 0, time.UTC), interval.ClosedOpen)"; got != want {
+		t.Errorf("Sprintf() = %v, want %v", got, want)
+	}
+	zonedNullable := NewNullableTimeInterval(nil, &[]time.Time{t1.In(tokyo)}[0], Open)
+	if got, want := fmt.Sprintf("%#v", zonedNullable), "interval.NewNullableTimeInterval(nil, &[]time.Time{time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC)}[0], interval.Open)"; got != want {
+		t.Errorf("Sprintf() = %v, want %v", got, want)
+	}
+
+	cents := ValueFormat{Float: func(f float64) string { return strconv.FormatFloat(f, 'f', 2, 64) + "€" }}
+	if got, want := fmt.Sprintf("%v", NewBaseInterval(1.0, 2.5).Formatted(cents)), "[1.00€,2.50€)"; got != want {
+		t.Errorf("Sprintf() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,377 @@
+package interval
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Strand is the strand of a genomic feature
+type Strand byte
+
+const (
+	StrandNone    Strand = '.'
+	StrandForward Strand = '+'
+	StrandReverse Strand = '-'
+	StrandUnknown Strand = '?'
+)
+
+const (
+	gffVersionHeader = "##gff-version 3"
+	gffFastaHeader   = "##FASTA"
+	missingField     = "."
+)
+
+// GenomicInterval is a feature on a chromosome with 0-based ClosedOpen coordinates [Start,End) as in BED files.
+// Extra holds the columns after the strand of a BED record, or the source, type, phase and attributes of a GFF3 record
+type GenomicInterval struct {
+	Chrom  string
+	Start  int64
+	End    int64
+	Strand Strand
+	Name   string
+	Score  string
+	Extra  []string
+}
+
+// NewGenomicInterval returns a new GenomicInterval of the 0-based [start,end)
+func NewGenomicInterval(chrom string, start, end int64, strand Strand) *GenomicInterval {
+	return &GenomicInterval{Chrom: chrom, Start: start, End: end, Strand: strand}
+}
+
+// NewOneBasedGenomicInterval returns a new GenomicInterval of the 1-based closed [start,end] used by GFF, VCF and SAM
+func NewOneBasedGenomicInterval(chrom string, start, end int64, strand Strand) *GenomicInterval {
+	return NewGenomicInterval(chrom, start-1, end, strand)
+}
+
+// OneBased returns the 1-based closed coordinates of this feature
+func (gi *GenomicInterval) OneBased() (start, end int64) {
+	return gi.Start + 1, gi.End
+}
+
+// Interval returns the 0-based coordinates of this feature as a ClosedOpen interval
+func (gi *GenomicInterval) Interval() *BaseInterval[int64] {
+	return NewBaseInterval[int64](gi.Start, gi.End, ClosedOpen)
+}
+
+// Len returns the number of bases of this feature
+func (gi *GenomicInterval) Len() int64 {
+	return max(0, gi.End-gi.Start)
+}
+
+// Contains returns true if the 0-based position on the chromosome is in this feature
+func (gi *GenomicInterval) Contains(chrom string, pos int64) bool {
+	return chrom == gi.Chrom && pos >= gi.Start && pos < gi.End
+}
+
+// Overlaps returns true if this feature and other share a base, whatever their strands
+func (gi *GenomicInterval) Overlaps(other *GenomicInterval) bool {
+	return gi.Chrom == other.Chrom && gi.Start < other.End && other.Start < gi.End
+}
+
+// String returns a readable string of this feature like "chr1:[100,200)+"
+func (gi *GenomicInterval) String() string {
+	s := gi.Chrom + ":" + gi.Interval().String()
+	if gi.Strand == StrandForward || gi.Strand == StrandReverse {
+		s += string(gi.Strand)
+	}
+	return s
+}
+
+func parseStrand(s string) (Strand, error) {
+	switch s {
+	case "", missingField:
+		return StrandNone, nil
+	case "+", "-", "?":
+		return Strand(s[0]), nil
+	}
+	return StrandNone, GenomicRecordErr
+}
+
+// ReadBED reads the records of a BED file, header, track, browser and comment lines are skipped
+func ReadBED(r io.Reader) ([]*GenomicInterval, error) {
+	var intervals []*GenomicInterval
+	err := scanRecords(r, func(line string) error {
+		if strings.HasPrefix(line, "track") || strings.HasPrefix(line, "browser") {
+			return nil
+		}
+		columns := strings.Split(line, "\t")
+		if len(columns) == 1 {
+			columns = strings.Fields(line)
+		}
+		if len(columns) < 3 {
+			return GenomicRecordErr
+		}
+		start, err := strconv.ParseInt(columns[1], 10, 64)
+		if err != nil {
+			return GenomicRecordErr
+		}
+		end, err := strconv.ParseInt(columns[2], 10, 64)
+		if err != nil || start < 0 || end < start {
+			return GenomicRecordErr
+		}
+		gi := NewGenomicInterval(columns[0], start, end, StrandNone)
+		if len(columns) > 3 {
+			gi.Name = columns[3]
+		}
+		if len(columns) > 4 {
+			gi.Score = columns[4]
+		}
+		if len(columns) > 5 {
+			if gi.Strand, err = parseStrand(columns[5]); err != nil {
+				return err
+			}
+		}
+		if len(columns) > 6 {
+			gi.Extra = columns[6:]
+		}
+		intervals = append(intervals, gi)
+		return nil
+	})
+	return intervals, err
+}
+
+// WriteBED writes the intervals as BED records with as many columns as their fields need
+func WriteBED(w io.Writer, intervals []*GenomicInterval) error {
+	bw := bufio.NewWriter(w)
+	for _, gi := range intervals {
+		columns := []string{gi.Chrom, strconv.FormatInt(gi.Start, 10), strconv.FormatInt(gi.End, 10)}
+		if gi.Name != "" || gi.Score != "" || gi.Strand != StrandNone && gi.Strand != 0 || len(gi.Extra) > 0 {
+			columns = append(columns, orMissing(gi.Name), orDefault(gi.Score, "0"), string(orStrand(gi.Strand)))
+			columns = append(columns, gi.Extra...)
+		}
+		if _, err := bw.WriteString(strings.Join(columns, "\t") + "\n"); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// ReadGFF3 reads the features of a GFF3 file converting them to 0-based coordinates,
+// Name is taken from the percent-decoded Name or ID attribute
+func ReadGFF3(r io.Reader) ([]*GenomicInterval, error) {
+	var intervals []*GenomicInterval
+	err := scanRecords(r, func(line string) error {
+		columns := strings.Split(line, "\t")
+		if len(columns) != 9 {
+			return GenomicRecordErr
+		}
+		start, err := strconv.ParseInt(columns[3], 10, 64)
+		if err != nil {
+			return GenomicRecordErr
+		}
+		end, err := strconv.ParseInt(columns[4], 10, 64)
+		if err != nil || start < 1 || end < start-1 {
+			return GenomicRecordErr
+		}
+		gi := NewOneBasedGenomicInterval(columns[0], start, end, StrandNone)
+		if gi.Strand, err = parseStrand(columns[6]); err != nil {
+			return err
+		}
+		if columns[5] != missingField {
+			gi.Score = columns[5]
+		}
+		gi.Extra = []string{columns[1], columns[2], columns[7], columns[8]}
+		for _, attr := range strings.Split(columns[8], ";") {
+			if k, v, found := strings.Cut(attr, "="); found && (k == "Name" || k == "ID" && gi.Name == "") {
+				if gi.Name, err = url.PathUnescape(v); err != nil {
+					return GenomicRecordErr
+				}
+			}
+		}
+		intervals = append(intervals, gi)
+		return nil
+	}, gffFastaHeader)
+	return intervals, err
+}
+
+// WriteGFF3 writes the intervals as GFF3 features in 1-based coordinates, source, type, phase and attributes
+// are taken from Extra and an interval without attributes gets an ID attribute from its percent-encoded Name
+func WriteGFF3(w io.Writer, intervals []*GenomicInterval) error {
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(gffVersionHeader + "\n"); err != nil {
+		return err
+	}
+	for _, gi := range intervals {
+		extra := make([]string, 4)
+		copy(extra, gi.Extra)
+		if extra[3] == "" && gi.Name != "" {
+			extra[3] = "ID=" + escapeGFF3(gi.Name)
+		}
+		start, end := gi.OneBased()
+		columns := []string{
+			gi.Chrom, orMissing(extra[0]), orMissing(extra[1]), strconv.FormatInt(start, 10), strconv.FormatInt(end, 10),
+			orMissing(gi.Score), string(orStrand(gi.Strand)), orMissing(extra[2]), orMissing(extra[3]),
+		}
+		if _, err := bw.WriteString(strings.Join(columns, "\t") + "\n"); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// escapeGFF3 percent-encodes the characters with a meaning in GFF3 columns and attributes and the control characters
+func escapeGFF3(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < ' ' || c == 0x7f || strings.IndexByte(";=&,%", c) >= 0 {
+			fmt.Fprintf(&sb, "%%%02X", c)
+		} else {
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// scanRecords calls record for every non empty and non comment line until a line starting with a stop prefix,
+// errors are reported with their line number
+func scanRecords(r io.Reader, record func(line string) error, stops ...string) error {
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		for _, stop := range stops {
+			if strings.HasPrefix(line, stop) {
+				return nil
+			}
+		}
+		if strings.Trim(line, Space) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if err := record(line); err != nil {
+			return fmt.Errorf("%w: line %d", err, n)
+		}
+	}
+	return scanner.Err()
+}
+
+func orMissing(s string) string {
+	return orDefault(s, missingField)
+}
+
+func orDefault(s, d string) string {
+	if s == "" {
+		return d
+	}
+	return s
+}
+
+func orStrand(s Strand) Strand {
+	if s == 0 {
+		return StrandNone
+	}
+	return s
+}
+
+// genomicChrom holds the features of a chromosome sorted by start,
+// maxEnd[i] is the index of the feature with the greatest end among the first i+1 features
+type genomicChrom struct {
+	features []*GenomicInterval
+	maxEnd   []int
+}
+
+// GenomicIndex answers overlap and nearest feature queries per chromosome
+type GenomicIndex struct {
+	chroms map[string]*genomicChrom
+}
+
+// NewGenomicIndex returns an index of the features
+func NewGenomicIndex(features []*GenomicInterval) *GenomicIndex {
+	idx := &GenomicIndex{chroms: map[string]*genomicChrom{}}
+	for _, gi := range features {
+		c, exist := idx.chroms[gi.Chrom]
+		if !exist {
+			c = &genomicChrom{}
+			idx.chroms[gi.Chrom] = c
+		}
+		c.features = append(c.features, gi)
+	}
+	for _, c := range idx.chroms {
+		sort.SliceStable(c.features, func(i, j int) bool {
+			return c.features[i].Start < c.features[j].Start
+		})
+		c.maxEnd = make([]int, len(c.features))
+		for i, gi := range c.features {
+			if i > 0 && c.features[c.maxEnd[i-1]].End >= gi.End {
+				c.maxEnd[i] = c.maxEnd[i-1]
+			} else {
+				c.maxEnd[i] = i
+			}
+		}
+	}
+	return idx
+}
+
+// Overlapping returns the features sharing a base with the query in ascending order of start
+func (idx *GenomicIndex) Overlapping(query *GenomicInterval) []*GenomicInterval {
+	c, exist := idx.chroms[query.Chrom]
+	if !exist {
+		return nil
+	}
+	n := sort.Search(len(c.features), func(i int) bool {
+		return c.features[i].Start >= query.End
+	})
+	var result []*GenomicInterval
+	// no feature before i ends after the query starts once maxEnd says so
+	for i := n - 1; i >= 0 && c.features[c.maxEnd[i]].End > query.Start; i-- {
+		if c.features[i].Overlaps(query) {
+			result = append(result, c.features[i])
+		}
+	}
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return result
+}
+
+// Nearest returns the feature closest to the query on its chromosome with the number of bases between them,
+// which is 0 if they overlap or touch. ok is false if the chromosome has no feature
+func (idx *GenomicIndex) Nearest(query *GenomicInterval) (nearest *GenomicInterval, distance int64, ok bool) {
+	if overlapping := idx.Overlapping(query); len(overlapping) > 0 {
+		return overlapping[0], 0, true
+	}
+	c, exist := idx.chroms[query.Chrom]
+	if !exist || len(c.features) == 0 {
+		return nil, 0, false
+	}
+	// the first feature downstream, and the upstream feature with the greatest end
+	n := sort.Search(len(c.features), func(i int) bool {
+		return c.features[i].Start >= query.End
+	})
+	if n < len(c.features) {
+		nearest, distance, ok = c.features[n], c.features[n].Start-query.End, true
+	}
+	if n > 0 {
+		if up := c.features[c.maxEnd[n-1]]; !ok || query.Start-up.End <= distance {
+			nearest, distance, ok = up, query.Start-up.End, true
+		}
+	}
+	return nearest, distance, ok
+}
+
+// MergeGenomic merges the overlapping and touching features of every chromosome whatever their strands,
+// the merged features are sorted by chromosome and start and only keep their coordinates
+func MergeGenomic(features []*GenomicInterval) []*GenomicInterval {
+	idx := NewGenomicIndex(features)
+	chroms := make([]string, 0, len(idx.chroms))
+	for chrom := range idx.chroms {
+		chroms = append(chroms, chrom)
+	}
+	sort.Strings(chroms)
+	var merged []*GenomicInterval
+	for _, chrom := range chroms {
+		var last *GenomicInterval
+		for _, gi := range idx.chroms[chrom].features {
+			if last != nil && gi.Start <= last.End {
+				last.End = max(last.End, gi.End)
+				continue
+			}
+			last = NewGenomicInterval(chrom, gi.Start, gi.End, StrandNone)
+			merged = append(merged, last)
+		}
+	}
+	return merged
+}
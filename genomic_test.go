@@ -0,0 +1,135 @@
+package interval
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadBED(t *testing.T) {
+	bed := "track name=test\n# comment\nchr1\t0\t100\tgeneA\t960\t+\t0\t100\nchr1\t150\t200\n\nchr2\t10\t20\tgeneB\t0\t-\n"
+	got, err := ReadBED(strings.NewReader(bed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*GenomicInterval{
+		{Chrom: "chr1", Start: 0, End: 100, Strand: StrandForward, Name: "geneA", Score: "960", Extra: []string{"0", "100"}},
+		{Chrom: "chr1", Start: 150, End: 200, Strand: StrandNone},
+		{Chrom: "chr2", Start: 10, End: 20, Strand: StrandReverse, Name: "geneB", Score: "0"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadBED() = %v, want %v", got, want)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := WriteBED(buf, got); err != nil {
+		t.Fatal(err)
+	}
+	if want := "chr1\t0\t100\tgeneA\t960\t+\t0\t100\nchr1\t150\t200\nchr2\t10\t20\tgeneB\t0\t-\n"; buf.String() != want {
+		t.Errorf("WriteBED() = %q, want %q", buf.String(), want)
+	}
+
+	if _, err := ReadBED(strings.NewReader("chr1\t0\t100\nchr1\t200\t100\n")); !errors.Is(err, GenomicRecordErr) || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("ReadBED() error = %v, want %v at line 2", err, GenomicRecordErr)
+	}
+}
+
+func TestReadGFF3(t *testing.T) {
+	gff := "##gff-version 3\nchr1\tsrc\tgene\t1\t100\t.\t+\t.\tID=g1;Name=geneA\nchr1\tsrc\texon\t51\t60\t0.5\t-\t0\tID=e1\n##FASTA\n>chr1\nACGT\n"
+	got, err := ReadGFF3(strings.NewReader(gff))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*GenomicInterval{
+		{Chrom: "chr1", Start: 0, End: 100, Strand: StrandForward, Name: "geneA", Extra: []string{"src", "gene", ".", "ID=g1;Name=geneA"}},
+		{Chrom: "chr1", Start: 50, End: 60, Strand: StrandReverse, Name: "e1", Score: "0.5", Extra: []string{"src", "exon", "0", "ID=e1"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadGFF3() = %v, want %v", got, want)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := WriteGFF3(buf, got); err != nil {
+		t.Fatal(err)
+	}
+	if want := strings.TrimSuffix(gff, "##FASTA\n>chr1\nACGT\n"); buf.String() != want {
+		t.Errorf("WriteGFF3() = %q, want %q", buf.String(), want)
+	}
+
+	// a BED feature keeps its bases when written as GFF3
+	buf.Reset()
+	if err := WriteGFF3(buf, []*GenomicInterval{{Chrom: "chr2", Start: 9, End: 20, Name: "x"}}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "##gff-version 3\nchr2\t.\t.\t10\t20\t.\t.\t.\tID=x\n"; buf.String() != want {
+		t.Errorf("WriteGFF3() = %q, want %q", buf.String(), want)
+	}
+
+	// names with reserved characters are percent-encoded
+	buf.Reset()
+	name := "a;b=c&d,e%f\tg h"
+	if err := WriteGFF3(buf, []*GenomicInterval{{Chrom: "chr2", Start: 9, End: 20, Name: name}}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "##gff-version 3\nchr2\t.\t.\t10\t20\t.\t.\t.\tID=a%3Bb%3Dc%26d%2Ce%25f%09g h\n"; buf.String() != want {
+		t.Errorf("WriteGFF3() = %q, want %q", buf.String(), want)
+	}
+	if got, err := ReadGFF3(buf); err != nil || len(got) != 1 || got[0].Name != name {
+		t.Errorf("ReadGFF3() = %v, %v", got, err)
+	}
+	if _, err := ReadGFF3(strings.NewReader("chr1\t.\t.\t1\t2\t.\t.\t.\tID=a%zz\n")); !errors.Is(err, GenomicRecordErr) {
+		t.Errorf("ReadGFF3() err = %v", err)
+	}
+}
+
+func TestGenomicIndex(t *testing.T) {
+	features := []*GenomicInterval{
+		NewGenomicInterval("chr1", 100, 200, StrandForward),
+		NewGenomicInterval("chr1", 0, 1000, StrandReverse),
+		NewGenomicInterval("chr1", 300, 400, StrandNone),
+		NewGenomicInterval("chr1", 1500, 1600, StrandNone),
+		NewGenomicInterval("chr2", 50, 60, StrandNone),
+	}
+	idx := NewGenomicIndex(features)
+
+	got := idx.Overlapping(NewGenomicInterval("chr1", 150, 350, StrandNone))
+	if want := []*GenomicInterval{features[1], features[0], features[2]}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Overlapping() = %v, want %v", got, want)
+	}
+	if got := idx.Overlapping(NewGenomicInterval("chr1", 1000, 1500, StrandNone)); len(got) != 0 {
+		t.Errorf("Overlapping() = %v, want none for touching features", got)
+	}
+
+	tests := []struct {
+		query    *GenomicInterval
+		want     *GenomicInterval
+		distance int64
+		ok       bool
+	}{
+		{query: NewGenomicInterval("chr1", 1100, 1200, StrandNone), want: features[1], distance: 100, ok: true},
+		{query: NewGenomicInterval("chr1", 1400, 1450, StrandNone), want: features[3], distance: 50, ok: true},
+		{query: NewGenomicInterval("chr1", 2000, 2001, StrandNone), want: features[3], distance: 400, ok: true},
+		{query: NewGenomicInterval("chr2", 0, 50, StrandNone), want: features[4], distance: 0, ok: true},
+		{query: NewGenomicInterval("chrX", 0, 50, StrandNone)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query.String(), func(t *testing.T) {
+			got, distance, ok := idx.Nearest(tt.query)
+			if got != tt.want || distance != tt.distance || ok != tt.ok {
+				t.Errorf("Nearest() = %v %v %v, want %v %v %v", got, distance, ok, tt.want, tt.distance, tt.ok)
+			}
+		})
+	}
+
+	merged := MergeGenomic(append(features, NewGenomicInterval("chr1", 1000, 1200, StrandNone)))
+	want := []*GenomicInterval{
+		NewGenomicInterval("chr1", 0, 1200, StrandNone),
+		NewGenomicInterval("chr1", 1500, 1600, StrandNone),
+		NewGenomicInterval("chr2", 50, 60, StrandNone),
+	}
+	if !reflect.DeepEqual(merged, want) {
+		t.Errorf("MergeGenomic() = %v, want %v", merged, want)
+	}
+}
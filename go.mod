@@ -1,3 +1,3 @@
 module github.com/dingyou/interval
 
-go 1.18
+go 1.23
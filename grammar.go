@@ -0,0 +1,186 @@
+package interval
+
+import (
+	"strings"
+	"unicode"
+)
+
+// WhitespacePolicy decides where whitespace is allowed in an interval string
+type WhitespacePolicy uint8
+
+const (
+	// TrimWhitespace trims the whitespace around the values, "[1, 2)" is valid
+	TrimWhitespace WhitespacePolicy = iota
+	// IgnoreWhitespace also trims the whitespace around the whole string, " [1, 2) " is valid
+	IgnoreWhitespace
+	// StrictWhitespace rejects any whitespace, only "[1,2)" is valid
+	StrictWhitespace
+)
+
+// Grammar describes the text form of an interval, every Parse function of the package has a Grammar variant,
+// the package level ones use DefaultGrammar
+type Grammar struct {
+	// LeftClosed, LeftOpen, RightClosed and RightOpen are the bracket tokens
+	LeftClosed  string
+	LeftOpen    string
+	RightClosed string
+	RightOpen   string
+	// Separator separates the left value from the right value
+	Separator string
+	// DecimalComma reads numbers like "1,5" as 1.5, the Separator must not be "," then
+	DecimalComma bool
+	// Whitespace is the whitespace policy
+	Whitespace WhitespacePolicy
+	// NullTokens are the case insensitive tokens of a missing NullableTimeInterval value
+	NullTokens []string
+	// PosInfTokens and NegInfTokens are the case insensitive tokens of the infinities. The integer, time,
+	// nullable time and Cmp parsers read them as Unbounded ends, a negative infinity only on the left and a
+	// positive one only on the right. Strings have no infinity.
+	// The float parsers are the exception, they read them as the bounded float values ±Inf as strconv does,
+	// so "[0,+inf)" of ParseFloatInterval is not Unbounded: the right end is the excluded value +Inf, it does
+	// not enclose and differs in Length, Hausdorff and the bounds from an interval with an Unbounded end.
+	// ParseSetExpr turns infinite float ends into Unbounded ends
+	PosInfTokens []string
+	NegInfTokens []string
+}
+
+var (
+	// DefaultGrammar is the grammar of "[1,2)"
+	DefaultGrammar = Grammar{
+		LeftClosed:   LeftClosed,
+		LeftOpen:     LeftOpen,
+		RightClosed:  RightClosed,
+		RightOpen:    RightOpen,
+		Separator:    Spacer,
+		NullTokens:   []string{NullFlag},
+		PosInfTokens: []string{"+inf", "inf", "+∞", "∞"},
+		NegInfTokens: []string{"-inf", "-∞"},
+	}
+	// ISOGrammar is the ISO 31-11 grammar with reversed brackets for open ends, like "]1,2]"
+	ISOGrammar = Grammar{
+		LeftClosed:   LeftClosed,
+		LeftOpen:     RightClosed,
+		RightClosed:  RightClosed,
+		RightOpen:    LeftClosed,
+		Separator:    Spacer,
+		NullTokens:   []string{NullFlag},
+		PosInfTokens: []string{"+inf", "inf", "+∞", "∞"},
+		NegInfTokens: []string{"-inf", "-∞"},
+	}
+)
+
+// blowUp splits str to its open closed type and the left and right value strings
+func (g *Grammar) blowUp(str string) (openClosedType OpenClosedType, lv, rv string, err error) {
+	if g.Whitespace == IgnoreWhitespace {
+		str = strings.TrimSpace(str)
+	}
+	minLen := min(len(g.LeftClosed), len(g.LeftOpen)) + len(g.Separator) + min(len(g.RightClosed), len(g.RightOpen)) + 2
+	if len(str) < minLen {
+		return Open, "", "", ParseTooShortErr
+	}
+	leftClosed, leftLen, leftErr := g.flag(str, g.LeftClosed, g.LeftOpen, strings.HasPrefix)
+	rightClosed, rightLen, rightErr := g.flag(str, g.RightClosed, g.RightOpen, strings.HasSuffix)
+	values := strings.Split(str[leftLen:len(str)-rightLen], g.Separator)
+	if len(values) != 2 {
+		return Open, "", "", ValueStrErr
+	}
+	if leftErr != nil || rightErr != nil {
+		return Open, "", "", OpenClosedFlagErr
+	}
+	if leftClosed {
+		openClosedType |= ClosedOpen
+	}
+	if rightClosed {
+		openClosedType |= OpenClosed
+	}
+	if lv, err = g.value(values[0]); err != nil {
+		return Open, "", "", err
+	}
+	if rv, err = g.value(values[1]); err != nil {
+		return Open, "", "", err
+	}
+	return openClosedType, lv, rv, nil
+}
+
+// flag matches the closed or the open token at one end of str, the longer token is tried first,
+// n is the length of the matched token, or of one byte if nothing matches
+func (g *Grammar) flag(str, closedToken, openToken string, has func(s, token string) bool) (closed bool, n int, err error) {
+	if len(openToken) > len(closedToken) && has(str, openToken) {
+		return false, len(openToken), nil
+	}
+	if has(str, closedToken) {
+		return true, len(closedToken), nil
+	}
+	if has(str, openToken) {
+		return false, len(openToken), nil
+	}
+	return false, 1, OpenClosedFlagErr
+}
+
+// value applies the whitespace policy to a value string
+func (g *Grammar) value(v string) (string, error) {
+	if g.Whitespace == StrictWhitespace {
+		if strings.IndexFunc(v, unicode.IsSpace) >= 0 {
+			return "", ValueStrErr
+		}
+		return v, nil
+	}
+	return strings.TrimSpace(v), nil
+}
+
+// number returns v in the decimal point form of strconv
+func (g *Grammar) number(v string) string {
+	if g.DecimalComma {
+		return strings.ReplaceAll(v, ",", ".")
+	}
+	return v
+}
+
+// isNull returns true if v is a null token
+func (g *Grammar) isNull(v string) bool {
+	return matchToken(v, g.NullTokens)
+}
+
+// infinity returns 1 or -1 if v is a positive or negative infinity token, or 0
+func (g *Grammar) infinity(v string) int {
+	switch {
+	case matchToken(v, g.PosInfTokens):
+		return 1
+	case matchToken(v, g.NegInfTokens):
+		return -1
+	}
+	return 0
+}
+
+// parseBounds parse the value strings of both ends with parse, the infinity tokens are parsed to Unbounded ends,
+// a negative infinity is only valid on the left and a positive one only on the right
+func parseBounds[T any](g *Grammar, openClosedType OpenClosedType, lv, rv string, parse func(string) (T, error)) (lower, upper Bound[T], err error) {
+	if lower, err = parseBound(g, lv, -1, openClosedType&ClosedOpen == ClosedOpen, parse); err != nil {
+		return
+	}
+	upper, err = parseBound(g, rv, 1, openClosedType&OpenClosed == OpenClosed, parse)
+	return
+}
+
+// parseBound parse the value string of the end on side, -1 for the left and 1 for the right
+func parseBound[T any](g *Grammar, v string, side int, closed bool, parse func(string) (T, error)) (Bound[T], error) {
+	if sign := g.infinity(v); sign == side {
+		return UnboundedBound[T](), nil
+	} else if sign != 0 {
+		return Bound[T]{}, ValueStrErr
+	}
+	value, err := parse(v)
+	if err != nil {
+		return Bound[T]{}, err
+	}
+	return newBound(value, closed), nil
+}
+
+func matchToken(v string, tokens []string) bool {
+	for _, token := range tokens {
+		if strings.EqualFold(v, token) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package interval
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGrammar_ParseFloatInterval(t *testing.T) {
+	european := DefaultGrammar
+	european.Separator = ";"
+	european.DecimalComma = true
+	dotted := DefaultGrammar
+	dotted.Separator = ".."
+	strict := DefaultGrammar
+	strict.Whitespace = StrictWhitespace
+	loose := DefaultGrammar
+	loose.Whitespace = IgnoreWhitespace
+	tests := []struct {
+		name    string
+		g       Grammar
+		str     string
+		want    *BaseInterval[float64]
+		wantErr error
+	}{
+		{name: "default", g: DefaultGrammar, str: "[1.5, 2)", want: NewBaseInterval(1.5, 2.0, ClosedOpen)},
+		{name: "infinity", g: DefaultGrammar, str: "(-inf, +∞)", want: NewBaseInterval(math.Inf(-1), math.Inf(1), Open)},
+		{name: "iso open", g: ISOGrammar, str: "]1,5[", want: NewBaseInterval(1.0, 5.0, Open)},
+		{name: "iso mixed", g: ISOGrammar, str: "[1,5[", want: NewBaseInterval(1.0, 5.0, ClosedOpen)},
+		{name: "iso left open", g: ISOGrammar, str: "]1,5]", want: NewBaseInterval(1.0, 5.0, OpenClosed)},
+		{name: "iso parenthesis", g: ISOGrammar, str: "(1,5)", wantErr: OpenClosedFlagErr},
+		{name: "decimal comma", g: european, str: "[1,5;2,5]", want: NewBaseInterval(1.5, 2.5, Closed)},
+		{name: "dots", g: dotted, str: "[1.5..2.5)", want: NewBaseInterval(1.5, 2.5, ClosedOpen)},
+		{name: "strict", g: strict, str: "[1, 2)", wantErr: ValueStrErr},
+		{name: "trim", g: DefaultGrammar, str: " [1,2)", wantErr: OpenClosedFlagErr},
+		{name: "ignore", g: loose, str: " [1, 2) ", want: NewBaseInterval(1.0, 2.0, ClosedOpen)},
+		{name: "too short", g: DefaultGrammar, str: "[1,)", wantErr: ParseTooShortErr},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.g.ParseFloatInterval(tt.str)
+			if err != tt.wantErr {
+				t.Fatalf("ParseFloatInterval() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseFloatInterval() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrammar_Tokens(t *testing.T) {
+	i, err := ParseIntInterval("[60, +INF)")
+	if err != nil || !reflect.DeepEqual(i, NewBaseIntervalFromBounds(IncludedBound[int64](60), UnboundedBound[int64]())) {
+		t.Errorf("ParseIntInterval() = %v, %v", i, err)
+	}
+	u, err := ParseBaseInterval[uint8]("[-inf, inf]")
+	if err != nil || !reflect.DeepEqual(u, NewBaseIntervalFromBounds(UnboundedBound[uint8](), UnboundedBound[uint8]())) ||
+		u.Left() != 0 || u.Right() != 0 || !u.Contains(math.MaxUint8) {
+		t.Errorf("ParseBaseInterval[uint8]() = %v, %v", u, err)
+	}
+	// the float parsers keep the infinities as bounded values
+	if f, err := ParseFloatInterval("[0,+inf)"); err != nil || f.UpperBound().Kind() != Excluded || !math.IsInf(f.Right(), 1) {
+		t.Errorf("ParseFloatInterval() = %v, %v", f, err)
+	}
+	// an infinity on the wrong side is no Unbounded end
+	if i, err = ParseIntInterval("[+inf,5]"); err == nil {
+		t.Errorf("ParseIntInterval() = %v, want error", i)
+	}
+	if i, err = ParseIntInterval("[1,-inf]"); err == nil {
+		t.Errorf("ParseIntInterval() = %v, want error", i)
+	}
+	if u, err = ParseBaseInterval[uint8]("[inf,-inf]"); err == nil {
+		t.Errorf("ParseBaseInterval[uint8]() = %v, want error", u)
+	}
+	if ti, err := ParseTimeInterval("[2023-01-02T00:00:00Z, -inf)"); err == nil {
+		t.Errorf("ParseTimeInterval() = %v, want error", ti)
+	}
+	if nti, err := ParseNullableTimeInterval("[+inf, 2023-01-02T00:00:00Z)"); err == nil {
+		t.Errorf("ParseNullableTimeInterval() = %v, want error", nti)
+	}
+	if set, err := ParseSetExpr[int]("[+inf,5]"); !errors.Is(err, SetExprErr) {
+		t.Errorf("ParseSetExpr() = %v, %v", set, err)
+	}
+
+	g := DefaultGrammar
+	g.NullTokens = []string{"-", "open"}
+	nti, err := g.ParseNullableTimeInterval("[2023-01-02T00:00:00Z, Open)")
+	if err != nil || nti.Right() != nil || !nti.Left().Equal(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("ParseNullableTimeInterval() = %v, %v", nti, err)
+	}
+	if _, err = g.ParseNullableTimeInterval("[2023-01-02T00:00:00Z, NULL)"); err == nil {
+		t.Errorf("ParseNullableTimeInterval() error = nil, want error")
+	}
+}
+
+func TestGrammar_ParseBox(t *testing.T) {
+	b, err := ISOGrammar.ParseBox("]0,1[x[2,5]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := NewBox(NewBaseInterval(0.0, 1.0, Open), NewBaseInterval(2.0, 5.0, Closed))
+	if !reflect.DeepEqual(b, want) {
+		t.Errorf("ParseBox() = %v, want %v", b, want)
+	}
+}
@@ -0,0 +1,212 @@
+package interval
+
+import (
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	byteRangeUnit = "bytes"
+	// maxHTTPRanges limits the ranges of one header, many small ranges are a known denial of service
+	maxHTTPRanges = 100
+)
+
+// ParseHTTPRange parses a Range header value like "bytes=0-499,1000-,-500" to closed intervals of byte
+// offsets resolved against the content size, in the order of the header. The unit is case-insensitive
+// and a header with more than 100 ranges is invalid. Ranges beyond the content are
+// dropped and HTTPRangeNotSatisfiableErr is returned if none is left
+func ParseHTTPRange(header string, size int64) ([]*BaseInterval[int64], error) {
+	unit, specs, found := strings.Cut(header, "=")
+	if !found || !strings.EqualFold(strings.Trim(unit, Space), byteRangeUnit) {
+		return nil, HTTPRangeErr
+	}
+	if strings.Count(specs, Spacer) >= maxHTTPRanges {
+		return nil, HTTPRangeErr
+	}
+	var ranges []*BaseInterval[int64]
+	satisfiable := false
+	for _, spec := range strings.Split(specs, Spacer) {
+		spec = strings.Trim(spec, Space)
+		if spec == "" {
+			continue
+		}
+		fv, lv, found := strings.Cut(spec, RangeDash)
+		if !found {
+			return nil, HTTPRangeErr
+		}
+		fv, lv = strings.Trim(fv, Space), strings.Trim(lv, Space)
+		if fv == "" {
+			// a suffix range selects the last bytes
+			n, err := strconv.ParseUint(lv, 10, 63)
+			if err != nil {
+				return nil, HTTPRangeErr
+			}
+			if n == 0 || size == 0 {
+				continue
+			}
+			ranges = append(ranges, NewBaseInterval[int64](size-min(int64(n), size), size-1, Closed))
+			satisfiable = true
+			continue
+		}
+		first, err := strconv.ParseUint(fv, 10, 63)
+		if err != nil {
+			return nil, HTTPRangeErr
+		}
+		last := uint64(size - 1)
+		if lv != "" {
+			if last, err = strconv.ParseUint(lv, 10, 63); err != nil || last < first {
+				return nil, HTTPRangeErr
+			}
+		}
+		if int64(first) >= size {
+			continue
+		}
+		ranges = append(ranges, NewBaseInterval[int64](int64(first), min(int64(last), size-1), Closed))
+		satisfiable = true
+	}
+	if !satisfiable {
+		return nil, HTTPRangeNotSatisfiableErr
+	}
+	return ranges, nil
+}
+
+// CoalesceHTTPRanges merges overlapping and adjacent byte ranges and sorts them
+func CoalesceHTTPRanges(ranges []*BaseInterval[int64]) []*BaseInterval[int64] {
+	return NewIntegerSet[int64](ranges...).Intervals()
+}
+
+// FormatContentRange formats a Content-Range header value like "bytes 0-499/1234",
+// a nil range gives the unsatisfied form "bytes */1234"
+func FormatContentRange(r *BaseInterval[int64], size int64) string {
+	if r == nil {
+		return fmt.Sprintf("%s */%d", byteRangeUnit, size)
+	}
+	first, last, _ := integerDomain[int64]().members(r.values())
+	return fmt.Sprintf("%s %d-%d/%d", byteRangeUnit, first, last, size)
+}
+
+// ServeHTTPRanges replies to the request with the content, honouring its Range header with a single part
+// or a multipart/byteranges response. Invalid Range headers are ignored as RFC 9110 requires
+func ServeHTTPRanges(w http.ResponseWriter, r *http.Request, content io.ReadSeeker, contentType string) {
+	size, err := content.Seek(0, io.SeekEnd)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Accept-Ranges", byteRangeUnit)
+	var ranges []*BaseInterval[int64]
+	if header := r.Header.Get("Range"); header != "" {
+		ranges, err = ParseHTTPRange(header, size)
+		if err == HTTPRangeNotSatisfiableErr {
+			w.Header().Set("Content-Range", FormatContentRange(nil, size))
+			http.Error(w, err.Error(), http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		ranges = CoalesceHTTPRanges(ranges)
+	}
+	switch len(ranges) {
+	case 0:
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+		w.WriteHeader(http.StatusOK)
+		if r.Method != http.MethodHead {
+			_ = copyRange(w, content, NewBaseInterval[int64](0, size))
+		}
+	case 1:
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Range", FormatContentRange(ranges[0], size))
+		w.Header().Set("Content-Length", strconv.FormatUint(Cardinality(ranges[0]), 10))
+		w.WriteHeader(http.StatusPartialContent)
+		if r.Method != http.MethodHead {
+			_ = copyRange(w, content, ranges[0])
+		}
+	default:
+		mw := multipart.NewWriter(w)
+		w.Header().Set("Content-Type", "multipart/byteranges; boundary="+mw.Boundary())
+		w.WriteHeader(http.StatusPartialContent)
+		if r.Method == http.MethodHead {
+			return
+		}
+		for _, br := range ranges {
+			part, err := mw.CreatePart(textproto.MIMEHeader{
+				"Content-Type":  {contentType},
+				"Content-Range": {FormatContentRange(br, size)},
+			})
+			if err != nil {
+				return
+			}
+			if err = copyRange(part, content, br); err != nil {
+				return
+			}
+		}
+		_ = mw.Close()
+	}
+}
+
+// copyRange copies the bytes of the content in the range to w
+func copyRange(w io.Writer, content io.ReadSeeker, br *BaseInterval[int64]) error {
+	first, _, ok := integerDomain[int64]().members(br.values())
+	if !ok {
+		return nil
+	}
+	if _, err := content.Seek(first, io.SeekStart); err != nil {
+		return err
+	}
+	_, err := io.CopyN(w, content, int64(Cardinality(br)))
+	return err
+}
+
+// seekReaderAt reads a shared io.ReadSeeker at offsets, the lock is held for each seek and read only
+type seekReaderAt struct {
+	mu      sync.Mutex
+	content io.ReadSeeker
+}
+
+func (s *seekReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.content.Seek(off, io.SeekStart); err != nil {
+		return 0, err
+	}
+	n, err := io.ReadFull(s.content, p)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// rangeHandler gives every request its own reader of the content, so a slow client blocks no other one
+type rangeHandler struct {
+	content     io.ReaderAt
+	size        int64
+	err         error
+	contentType string
+}
+
+// NewHTTPRangeHandler returns an http.Handler serving the content with ServeHTTPRanges, the size of the content
+// is read once and must not change. Contents implementing io.ReaderAt like *os.File are read concurrently,
+// the reads of other contents are serialized
+func NewHTTPRangeHandler(content io.ReadSeeker, contentType string) http.Handler {
+	h := &rangeHandler{contentType: contentType}
+	h.size, h.err = content.Seek(0, io.SeekEnd)
+	if ra, ok := content.(io.ReaderAt); ok {
+		h.content = ra
+	} else {
+		h.content = &seekReaderAt{content: content}
+	}
+	return h
+}
+
+func (h *rangeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.err != nil {
+		http.Error(w, h.err.Error(), http.StatusInternalServerError)
+		return
+	}
+	ServeHTTPRanges(w, r, io.NewSectionReader(h.content, 0, h.size), h.contentType)
+}
@@ -0,0 +1,150 @@
+package interval
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestParseHTTPRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  string
+		size    int64
+		want    []*BaseInterval[int64]
+		wantErr error
+	}{
+		{
+			name:   "mixed",
+			header: "bytes=0-499,1000-,-500",
+			size:   10000,
+			want: []*BaseInterval[int64]{
+				NewBaseInterval[int64](0, 499, Closed),
+				NewBaseInterval[int64](1000, 9999, Closed),
+				NewBaseInterval[int64](9500, 9999, Closed),
+			},
+		},
+		{
+			name:   "clamp",
+			header: "bytes= 50-200 , -500",
+			size:   100,
+			want:   []*BaseInterval[int64]{NewBaseInterval[int64](50, 99, Closed), NewBaseInterval[int64](0, 99, Closed)},
+		},
+		{name: "dropUnsatisfiable", header: "bytes=200-300,0-0", size: 100, want: []*BaseInterval[int64]{NewBaseInterval[int64](0, 0, Closed)}},
+		{name: "notSatisfiable", header: "bytes=100-,-0", size: 100, wantErr: HTTPRangeNotSatisfiableErr},
+		{name: "unit", header: "items=0-1", size: 100, wantErr: HTTPRangeErr},
+		{name: "reversed", header: "bytes=5-1", size: 100, wantErr: HTTPRangeErr},
+		{name: "sign", header: "bytes=+1-5", size: 100, wantErr: HTTPRangeErr},
+		{name: "noDash", header: "bytes=5", size: 100, wantErr: HTTPRangeErr},
+		{name: "unitCase", header: "Bytes=0-1", size: 100, want: []*BaseInterval[int64]{NewBaseInterval[int64](0, 1, Closed)}},
+		{name: "tooMany", header: "bytes=" + strings.Repeat("0-0,", 100) + "0-0", size: 100, wantErr: HTTPRangeErr},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseHTTPRange(tt.header, tt.size)
+			if err != tt.wantErr {
+				t.Errorf("ParseHTTPRange() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseHTTPRange() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCoalesceHTTPRanges(t *testing.T) {
+	got := CoalesceHTTPRanges([]*BaseInterval[int64]{
+		NewBaseInterval[int64](500, 999, Closed),
+		NewBaseInterval[int64](0, 99, Closed),
+		NewBaseInterval[int64](100, 199, Closed),
+		NewBaseInterval[int64](900, 1200, Closed),
+	})
+	want := []*BaseInterval[int64]{NewBaseInterval[int64](0, 199, Closed), NewBaseInterval[int64](500, 1200, Closed)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CoalesceHTTPRanges() = %v, want %v", got, want)
+	}
+}
+
+func TestFormatContentRange(t *testing.T) {
+	if got := FormatContentRange(NewBaseInterval[int64](0, 500), 1234); got != "bytes 0-499/1234" {
+		t.Errorf("FormatContentRange() = %v", got)
+	}
+	if got := FormatContentRange(nil, 1234); got != "bytes */1234" {
+		t.Errorf("FormatContentRange() = %v", got)
+	}
+}
+
+func TestNewHTTPRangeHandler(t *testing.T) {
+	content := "0123456789abcdefghij"
+	handler := NewHTTPRangeHandler(strings.NewReader(content), "text/plain")
+	serve := func(rangeHeader string) *http.Response {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if rangeHeader != "" {
+			req.Header.Set("Range", rangeHeader)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Result()
+	}
+
+	resp := serve("")
+	if body, _ := io.ReadAll(resp.Body); resp.StatusCode != http.StatusOK || string(body) != content {
+		t.Errorf("full: status = %v, body = %s", resp.StatusCode, body)
+	}
+
+	resp = serve("bytes=-5")
+	if body, _ := io.ReadAll(resp.Body); resp.StatusCode != http.StatusPartialContent || string(body) != "fghij" ||
+		resp.Header.Get("Content-Range") != "bytes 15-19/20" {
+		t.Errorf("single: status = %v, body = %s, header = %v", resp.StatusCode, body, resp.Header)
+	}
+
+	resp = serve("bytes=100-")
+	if resp.StatusCode != http.StatusRequestedRangeNotSatisfiable || resp.Header.Get("Content-Range") != "bytes */20" {
+		t.Errorf("unsatisfiable: status = %v, header = %v", resp.StatusCode, resp.Header)
+	}
+
+	resp = serve("bytes=0-1,15-,3-4,2-2")
+	mediaType, params, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/byteranges" || resp.StatusCode != http.StatusPartialContent {
+		t.Fatalf("multi: status = %v, content type = %v", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	mr := multipart.NewReader(resp.Body, params["boundary"])
+	var parts []string
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(part)
+		parts = append(parts, part.Header.Get("Content-Range")+" "+string(body))
+	}
+	if want := []string{"bytes 0-4/20 01234", "bytes 15-19/20 fghij"}; !reflect.DeepEqual(parts, want) {
+		t.Errorf("multi: parts = %v, want %v", parts, want)
+	}
+
+	// a content without ReadAt is shared by concurrent requests
+	handler = NewHTTPRangeHandler(struct{ io.ReadSeeker }{strings.NewReader(content)}, "text/plain")
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp := serve(fmt.Sprintf("bytes=%d-%d", i, i+9))
+			if body, _ := io.ReadAll(resp.Body); string(body) != content[i:i+10] {
+				t.Errorf("concurrent: body = %s, want %s", body, content[i:i+10])
+			}
+		}()
+	}
+	wg.Wait()
+}
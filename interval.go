@@ -1,9 +1,9 @@
 package interval
 
 import (
-	"bytes"
 	"fmt"
-	"strings"
+	"net/netip"
+	"time"
 )
 
 type OpenClosedType uint8
@@ -25,103 +25,102 @@ type IInterval[T any] interface {
 	String() string
 }
 
-type Interval[T SortComparable[T]] struct {
-	left           T
-	right          T
-	openClosedType OpenClosedType
+var (
+	_ IInterval[int]        = (*BaseInterval[int])(nil)
+	_ IInterval[SemVer]     = (*Interval[SemVer])(nil)
+	_ IInterval[time.Time]  = (*TimeInterval)(nil)
+	_ IInterval[*time.Time] = (*NullableTimeInterval)(nil)
+	_ IInterval[netip.Addr] = (*IPInterval)(nil)
+	_ IInterval[string]     = (*CmpInterval[string])(nil)
+
+	_ fmt.Stringer = (*BaseInterval[int])(nil)
+	_ fmt.Stringer = (*Interval[SemVer])(nil)
+	_ fmt.Stringer = (*TimeInterval)(nil)
+	_ fmt.Stringer = (*NullableTimeInterval)(nil)
+)
+
+// ContainsAll returns true if all the given elements are in i
+func ContainsAll[T any](i IInterval[T], es ...T) bool {
+	for _, e := range es {
+		if !i.Contains(e) {
+			return false
+		}
+	}
+	return true
 }
 
-// Left returns the left value of this interval
-func (i *Interval[T]) Left() T {
-	return i.left
+// ContainsAny returns true if any of the given elements is in i
+func ContainsAny[T any](i IInterval[T], es ...T) bool {
+	for _, e := range es {
+		if i.Contains(e) {
+			return true
+		}
+	}
+	return false
 }
 
-func (i *Interval[T]) Right() T {
-	return i.right
+// FilterContained returns the given elements which are in i, in their order
+func FilterContained[T any](i IInterval[T], es []T) []T {
+	var result []T
+	for _, e := range es {
+		if i.Contains(e) {
+			result = append(result, e)
+		}
+	}
+	return result
 }
 
-// OpenClosedType returns the OpenClosedType of this interval
-func (i *Interval[T]) OpenClosedType() OpenClosedType {
-	return i.openClosedType
+// Containing returns the given intervals which contain e, in their order
+func Containing[T any, I IInterval[T]](intervals []I, e T) []I {
+	var result []I
+	for _, i := range intervals {
+		if i.Contains(e) {
+			result = append(result, i)
+		}
+	}
+	return result
 }
 
-// LeftClosed returns true if this interval is a left-closed interval
-func (i *Interval[T]) LeftClosed() bool {
-	return i.openClosedType&ClosedOpen == ClosedOpen
+type Interval[T SortComparable[T]] struct {
+	bounds[T]
 }
 
-// RightClosed returns true if this interval is a right-closed interval
-func (i *Interval[T]) RightClosed() bool {
-	return i.openClosedType&OpenClosed == OpenClosed
+// NewInterval return a new Interval
+func NewInterval[T SortComparable[T]](left, right T, openCloseType ...OpenClosedType) *Interval[T] {
+	return &Interval[T]{bounds: newBounds(left, right, openCloseType)}
 }
 
-func (i *Interval[T]) Contains(e T) bool {
-	if Compare[T](i.left, e) < 0 && Compare[T](i.right, e) > 0 {
-		return true
-	}
-	if Compare[T](i.left, e) == 0 && i.openClosedType&ClosedOpen == ClosedOpen {
-		return true
-	}
-	if Compare[T](i.right, e) == 0 && i.openClosedType&OpenClosed == OpenClosed {
-		return true
-	}
-	return false
+// NewIntervalFromBounds return a new Interval between the given bounds, which may be Unbounded
+func NewIntervalFromBounds[T SortComparable[T]](lower, upper Bound[T]) *Interval[T] {
+	return &Interval[T]{bounds: bounds[T]{lower: lower, upper: upper}}
 }
 
-// String returns a readable string of this interval
-func (i Interval[T]) String() string {
-	bs := bytes.Buffer{}
-	if i.LeftClosed() {
-		bs.WriteString(LeftClosed)
-	} else {
-		bs.WriteString(LeftOpen)
-	}
-	bs.WriteString(fmt.Sprintf("%v", i.left))
-	bs.WriteString(Spacer)
-	bs.WriteString(fmt.Sprintf("%v", i.right))
-	if i.RightClosed() {
-		bs.WriteString(RightClosed)
-	} else {
-		bs.WriteString(RightOpen)
-	}
-	return bs.String()
+func newIntervalCuts[T SortComparable[T]](lower, upper cut[T]) *Interval[T] {
+	return NewIntervalFromBounds(lowerBound(lower), upperBound(upper))
 }
 
-func blowUp(str string) (string, string, string, string, error) {
-	strLen := len(str)
-	if strLen < 5 {
-		return "", "", "", "", ParseTooShortErr
-	}
-	leftFlag, rightFlag := string(str[0]), string(str[strLen-1])
-	str = str[1 : strLen-1]
-	values := strings.Split(str, Spacer)
-	if len(values) != 2 {
-		return "", "", "", "", ValueStrErr
-	}
-	return leftFlag, strings.Trim(values[0], Space), strings.Trim(values[1], Space), rightFlag, nil
+// Left returns the left value of this interval, or the zero value of T if it is Unbounded
+func (i *Interval[T]) Left() T {
+	return i.lower.value
 }
 
-func isClosedFlag(in string) (bool, error) {
-	if _, exist := OpenFlags[in]; exist {
-		return false, nil
-	}
-	if _, exist := ClosedFlags[in]; exist {
-		return true, nil
-	}
-	return false, OpenClosedFlagErr
+// Right returns the right value of this interval, or the zero value of T if it is Unbounded
+func (i *Interval[T]) Right() T {
+	return i.upper.value
 }
 
-func getOpenClosedType(lf, rf string) (OpenClosedType, error) {
-	openClosedType := Open
-	if closed, err := isClosedFlag(lf); err != nil {
-		return openClosedType, err
-	} else if closed {
-		openClosedType |= ClosedOpen
-	}
-	if closed, err := isClosedFlag(rf); err != nil {
-		return openClosedType, err
-	} else if closed {
-		openClosedType |= OpenClosed
-	}
-	return openClosedType, nil
+// values returns the values and the OpenClosedType of this interval, an Unbounded end is the zero value of T,
+// so callers check isBounded first
+func (i *Interval[T]) values() (left, right T, t OpenClosedType) {
+	return i.lower.value, i.upper.value, i.OpenClosedType()
+}
+
+// Contains returns true if the given element is in this interval
+func (i *Interval[T]) Contains(e T) bool {
+	return i.contains(Compare[T], e)
+}
+
+// String returns a readable string of this interval, Unbounded ends are written as "-inf" and "+inf"
+func (i Interval[T]) String() string {
+	return i.string(func(v T) string { return fmt.Sprintf("%v", v) }, "-inf", "+inf")
 }
@@ -0,0 +1,37 @@
+package interval
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestContainsHelpers(t *testing.T) {
+	t1 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(24 * time.Hour)
+	ti := NewTimeInterval(t1, t2)
+	if !ContainsAll[time.Time](ti, t1, t1.Add(time.Hour)) || ContainsAll[time.Time](ti, t1, t2) {
+		t.Errorf("ContainsAll() of %v is wrong", ti)
+	}
+	if !ContainsAny[time.Time](ti, t2, t1) || ContainsAny[time.Time](ti, t2) {
+		t.Errorf("ContainsAny() of %v is wrong", ti)
+	}
+	nti := NewNullableTimeInterval(&t1, nil)
+	if got := FilterContained[*time.Time](nti, []*time.Time{nil, &t2, &t1}); !reflect.DeepEqual(got, []*time.Time{&t2, &t1}) {
+		t.Errorf("FilterContained() = %v", got)
+	}
+	if got, want := ti.FormatLayout(time.DateOnly), "[2023-01-01,2023-01-02)"; got != want {
+		t.Errorf("FormatLayout() = %v, want %v", got, want)
+	}
+	if got, want := nti.FormatLayout(time.DateOnly), "[2023-01-01,NULL)"; got != want {
+		t.Errorf("FormatLayout() = %v, want %v", got, want)
+	}
+
+	intervals := []IInterval[int]{NewBaseInterval(0, 10), NewBaseInterval(5, 6, Closed), NewCmpInterval(compareBase[int], 6, 9)}
+	if got := Containing(intervals, 6); !reflect.DeepEqual(got, intervals) {
+		t.Errorf("Containing() = %v", got)
+	}
+	if got := Containing(intervals, 5); !reflect.DeepEqual(got, intervals[:2]) {
+		t.Errorf("Containing() = %v", got)
+	}
+}
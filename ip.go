@@ -0,0 +1,198 @@
+package interval
+
+import (
+	"encoding/binary"
+	"math"
+	"net/netip"
+	"strings"
+)
+
+// IPInterval is an interval of IP addresses, both ends should be of the same family
+type IPInterval struct {
+	bounds[netip.Addr]
+}
+
+// NewIPInterval return a new IPInterval, ends of different families give the empty interval (left,left)
+func NewIPInterval(left, right netip.Addr, openCloseType ...OpenClosedType) *IPInterval {
+	if left.Is4() != right.Is4() {
+		return &IPInterval{bounds: newBounds(left, left, []OpenClosedType{Open})}
+	}
+	return &IPInterval{bounds: newBounds(left, right, openCloseType)}
+}
+
+// NewPrefixInterval returns the closed IPInterval of all addresses in prefix
+func NewPrefixInterval(prefix netip.Prefix) *IPInterval {
+	prefix = prefix.Masked()
+	return NewIPInterval(prefix.Addr(), prefixLastAddr(prefix), Closed)
+}
+
+// ParseIPInterval parse str to interval, str is either an interval like "[10.0.0.1,10.0.0.255]"
+// or a CIDR prefix like "10.0.0.0/24"
+func ParseIPInterval(intervalStr string) (ii *IPInterval, err error) {
+	return DefaultGrammar.ParseIPInterval(intervalStr)
+}
+
+// ParseIPInterval parse str to interval with this grammar
+func (g *Grammar) ParseIPInterval(intervalStr string) (ii *IPInterval, err error) {
+	if s := strings.Trim(intervalStr, Space); strings.Contains(s, "/") {
+		var prefix netip.Prefix
+		if prefix, err = netip.ParsePrefix(s); err != nil {
+			return nil, err
+		}
+		return NewPrefixInterval(prefix), nil
+	}
+	var openClosedType OpenClosedType
+	var lv, rv string
+	if openClosedType, lv, rv, err = g.blowUp(intervalStr); err != nil {
+		return
+	}
+	var la, ra netip.Addr
+	if la, err = netip.ParseAddr(lv); err != nil {
+		return nil, err
+	}
+	if ra, err = netip.ParseAddr(rv); err != nil {
+		return nil, err
+	}
+	if la.Is4() != ra.Is4() {
+		return nil, IPFamilyErr
+	}
+	return NewIPInterval(la, ra, openClosedType), nil
+}
+
+// Left returns the left value of this interval
+func (ii *IPInterval) Left() netip.Addr {
+	return ii.lower.value
+}
+
+// Right returns the right value of this interval
+func (ii *IPInterval) Right() netip.Addr {
+	return ii.upper.value
+}
+
+// Contains returns true if the given address is in this interval
+func (ii *IPInterval) Contains(e netip.Addr) bool {
+	return ii.contains(netip.Addr.Compare, e)
+}
+
+// String returns a readable string of this interval
+func (ii *IPInterval) String() string {
+	return ii.string(netip.Addr.String, "", "")
+}
+
+// Prefixes returns the minimal list of CIDR prefixes covering exactly this interval
+func (ii *IPInterval) Prefixes() []netip.Prefix {
+	first, last, ok := addrDomain.members(ii.Left(), ii.Right(), ii.OpenClosedType())
+	if !ok {
+		return nil
+	}
+	return rangePrefixes(first, last, nil)
+}
+
+// IPSet is a set of IP addresses built from IPInterval, it can be used to evaluate allow and deny lists
+type IPSet struct {
+	s *rangeSet[netip.Addr]
+}
+
+// NewIPSet returns the union of the given intervals
+func NewIPSet(intervals ...*IPInterval) *IPSet {
+	s := &rangeSet[netip.Addr]{cmp: netip.Addr.Compare, discrete: &addrDomain}
+	for _, ii := range intervals {
+		s = s.add(ii.cuts())
+	}
+	return &IPSet{s: s}
+}
+
+// Union returns a new set of the addresses in this set or other
+func (set *IPSet) Union(other *IPSet) *IPSet {
+	return &IPSet{s: set.s.union(other.s)}
+}
+
+// Intersect returns a new set of the addresses in both this set and other
+func (set *IPSet) Intersect(other *IPSet) *IPSet {
+	return &IPSet{s: set.s.intersect(other.s)}
+}
+
+// Difference returns a new set of the addresses in this set but not in other
+func (set *IPSet) Difference(other *IPSet) *IPSet {
+	return &IPSet{s: set.s.difference(other.s)}
+}
+
+// Contains returns true if the given address is in this set
+func (set *IPSet) Contains(e netip.Addr) bool {
+	return set.s.contains(e)
+}
+
+// Intervals returns the disjoint closed intervals of this set in ascending order
+func (set *IPSet) Intervals() []*IPInterval {
+	intervals := make([]*IPInterval, 0, len(set.s.spans))
+	for _, sp := range set.s.spans {
+		intervals = append(intervals, NewIPInterval(sp.lower.value, sp.upper.value, Closed))
+	}
+	return intervals
+}
+
+// Prefixes returns the minimal list of CIDR prefixes covering exactly this set
+func (set *IPSet) Prefixes() []netip.Prefix {
+	var prefixes []netip.Prefix
+	for _, sp := range set.s.spans {
+		prefixes = rangePrefixes(sp.lower.value, sp.upper.value, prefixes)
+	}
+	return prefixes
+}
+
+var addrDomain = discreteDomain[netip.Addr]{
+	cmp: netip.Addr.Compare,
+	next: func(a netip.Addr) (netip.Addr, bool) {
+		n := a.Next()
+		return n, n.IsValid()
+	},
+	prev: func(a netip.Addr) (netip.Addr, bool) {
+		p := a.Prev()
+		return p, p.IsValid()
+	},
+	distance: func(a, b netip.Addr) uint64 {
+		ab, bb := a.As16(), b.As16()
+		ah, al := binary.BigEndian.Uint64(ab[:8]), binary.BigEndian.Uint64(ab[8:])
+		bh, bl := binary.BigEndian.Uint64(bb[:8]), binary.BigEndian.Uint64(bb[8:])
+		if bl < al {
+			bh--
+		}
+		if bh != ah {
+			return math.MaxUint64
+		}
+		return bl - al
+	},
+}
+
+// prefixLastAddr returns the last address of a masked prefix
+func prefixLastAddr(prefix netip.Prefix) netip.Addr {
+	b := prefix.Addr().AsSlice()
+	for i := prefix.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 0x80 >> (i % 8)
+	}
+	a, _ := netip.AddrFromSlice(b)
+	return a
+}
+
+// rangePrefixes appends the minimal list of prefixes covering [first,last] to prefixes
+func rangePrefixes(first, last netip.Addr, prefixes []netip.Prefix) []netip.Prefix {
+	for {
+		// the shortest prefix starting at first which does not pass last
+		var prefix netip.Prefix
+		for bits := 0; bits <= first.BitLen(); bits++ {
+			prefix = netip.PrefixFrom(first, bits)
+			if prefix.Masked().Addr() == first && prefixLastAddr(prefix).Compare(last) <= 0 {
+				break
+			}
+		}
+		prefixes = append(prefixes, prefix)
+		end := prefixLastAddr(prefix)
+		if end == last {
+			return prefixes
+		}
+		// the last address of a family has no successor
+		if first = end.Next(); !first.IsValid() {
+			return prefixes
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package interval
+
+import (
+	"net/netip"
+	"reflect"
+	"testing"
+)
+
+func TestParseIPInterval(t *testing.T) {
+	tests := []struct {
+		name    string
+		str     string
+		want    *IPInterval
+		wantErr bool
+	}{
+		{
+			name: "closed",
+			str:  "[10.0.0.1,10.0.0.255]",
+			want: NewIPInterval(netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("10.0.0.255"), Closed),
+		},
+		{
+			name: "v6",
+			str:  "(::1, ::ff)",
+			want: NewIPInterval(netip.MustParseAddr("::1"), netip.MustParseAddr("::ff"), Open),
+		},
+		{
+			name: "cidr",
+			str:  "10.0.0.7/24",
+			want: NewIPInterval(netip.MustParseAddr("10.0.0.0"), netip.MustParseAddr("10.0.0.255"), Closed),
+		},
+		{
+			name:    "family",
+			str:     "[10.0.0.1,::1]",
+			wantErr: true,
+		},
+		{
+			name:    "value",
+			str:     "[10.0.0.1,10.0.0.x]",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseIPInterval(tt.str)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseIPInterval() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseIPInterval() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIPInterval_Prefixes(t *testing.T) {
+	tests := []struct {
+		name string
+		str  string
+		want []string
+	}{
+		{name: "single", str: "[10.0.0.0,10.0.0.255]", want: []string{"10.0.0.0/24"}},
+		{name: "unaligned", str: "[10.0.0.1,10.0.0.255]", want: []string{
+			"10.0.0.1/32", "10.0.0.2/31", "10.0.0.4/30", "10.0.0.8/29", "10.0.0.16/28",
+			"10.0.0.32/27", "10.0.0.64/26", "10.0.0.128/25",
+		}},
+		{name: "open", str: "(10.0.0.255,10.0.2.0)", want: []string{"10.0.1.0/24"}},
+		{name: "all", str: "[0.0.0.0,255.255.255.255]", want: []string{"0.0.0.0/0"}},
+		{name: "v6", str: "[::,::3]", want: []string{"::/126"}},
+		{name: "empty", str: "(10.0.0.1,10.0.0.2)", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ii, err := ParseIPInterval(tt.str)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, p := range ii.Prefixes() {
+				got = append(got, p.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Prefixes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewIPInterval_MixedFamily(t *testing.T) {
+	ii := NewIPInterval(netip.MustParseAddr("10.0.0.0"), netip.MustParseAddr("::1"), Closed)
+	if ii.Contains(netip.MustParseAddr("10.0.0.1")) || ii.Contains(netip.MustParseAddr("::1")) || ii.Prefixes() != nil {
+		t.Errorf("%v is not empty", ii)
+	}
+	if got := NewIPSet(ii).Intervals(); len(got) != 0 {
+		t.Errorf("NewIPSet() = %v", got)
+	}
+	got := rangePrefixes(netip.MustParseAddr("255.255.255.0"), netip.MustParseAddr("::1"), nil)
+	if want := []netip.Prefix{netip.MustParsePrefix("255.255.255.0/24")}; !reflect.DeepEqual(got, want) {
+		t.Errorf("rangePrefixes() = %v, want %v", got, want)
+	}
+}
+
+func TestIPSet(t *testing.T) {
+	mustParse := func(str string) *IPInterval {
+		ii, err := ParseIPInterval(str)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ii
+	}
+	allow := NewIPSet(mustParse("10.0.0.0/24"), mustParse("[10.0.1.0,10.0.1.10)"), mustParse("192.168.0.0/16"))
+	deny := NewIPSet(mustParse("[10.0.0.128,10.0.0.255]"), mustParse("192.168.1.1/32"))
+	effective := allow.Difference(deny)
+
+	var got []string
+	for _, ii := range effective.Intervals() {
+		got = append(got, ii.String())
+	}
+	want := []string{
+		"[10.0.0.0,10.0.0.127]",
+		"[10.0.1.0,10.0.1.9]",
+		"[192.168.0.0,192.168.1.0]",
+		"[192.168.1.2,192.168.255.255]",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Intervals() = %v, want %v", got, want)
+	}
+	for addr, want := range map[string]bool{
+		"10.0.0.1":    true,
+		"10.0.0.200":  false,
+		"10.0.1.10":   false,
+		"192.168.1.1": false,
+		"192.168.1.2": true,
+		"::1":         false,
+	} {
+		if got := effective.Contains(netip.MustParseAddr(addr)); got != want {
+			t.Errorf("Contains(%s) = %v, want %v", addr, got, want)
+		}
+	}
+
+	// adjacent ranges are merged
+	merged := NewIPSet(mustParse("[10.0.0.0,10.0.0.127]"), mustParse("[10.0.0.128,10.0.0.255]"))
+	if got := merged.Prefixes(); len(got) != 1 || got[0].String() != "10.0.0.0/24" {
+		t.Errorf("Prefixes() = %v, want [10.0.0.0/24]", got)
+	}
+	if got := allow.Intersect(deny).Union(NewIPSet()).Intervals(); len(got) != 2 {
+		t.Errorf("Intersect() = %v, want 2 intervals", got)
+	}
+}
@@ -0,0 +1,128 @@
+package interval
+
+import (
+	"iter"
+	"time"
+)
+
+// float is the set of basic floating point types
+type float interface {
+	~float32 | ~float64
+}
+
+// number is the set of basic numeric types
+type number interface {
+	integer | float
+}
+
+// Step returns an iterator over left, left+step, left+2*step... which are contained in the interval,
+// an open left end is skipped. Nothing is yielded if step is not positive or the left end is Unbounded or infinite
+func Step[T number](bi *BaseInterval[T], step T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		left, ok := bi.lower.Value()
+		if !(step > 0) || !ok || left-left != 0 {
+			return
+		}
+		prev := left
+		if bi.Contains(prev) && !yield(prev) {
+			return
+		}
+		// multiplying instead of accumulating keeps float values exact as long as possible,
+		// a value not greater than the previous one means overflow or a step below precision
+		for k := 1; ; k++ {
+			v := left + T(k)*step
+			if v <= prev || !bi.Contains(v) {
+				return
+			}
+			if !yield(v) {
+				return
+			}
+			prev = v
+		}
+	}
+}
+
+// Step returns an iterator over left, left+d, left+2*d... which are contained in this interval,
+// an open left end is skipped. Nothing is yielded if d is not positive or the left end is Unbounded
+func (ti *TimeInterval) Step(d time.Duration) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		if d <= 0 || ti.lower.kind == Unbounded {
+			return
+		}
+		if ti.Contains(ti.Left()) && !yield(ti.Left()) {
+			return
+		}
+		for k := time.Duration(1); ; k++ {
+			v := ti.Left().Add(k * d)
+			if !ti.Contains(v) || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the segments of this overlay in ascending order
+func (bo *BaseOverlay[T, V]) All() iter.Seq[BaseSegment[T, V]] {
+	return func(yield func(BaseSegment[T, V]) bool) {
+		for _, s := range bo.Segments() {
+			if !yield(s) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the segments of this overlay in ascending order
+func (to *TimeOverlay[V]) All() iter.Seq[TimeSegment[V]] {
+	return func(yield func(TimeSegment[V]) bool) {
+		for _, s := range to.Segments() {
+			if !yield(s) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the disjoint intervals of this set in ascending order
+func (set *IntervalSet[T]) All() iter.Seq[*Interval[T]] {
+	return func(yield func(*Interval[T]) bool) {
+		for _, i := range set.Intervals() {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the disjoint intervals of this set in ascending order
+func (set *BaseIntervalSet[T]) All() iter.Seq[*BaseInterval[T]] {
+	return func(yield func(*BaseInterval[T]) bool) {
+		for _, i := range set.Intervals() {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the disjoint intervals of this set in ascending order
+func (set *CmpIntervalSet[T]) All() iter.Seq[*CmpInterval[T]] {
+	return func(yield func(*CmpInterval[T]) bool) {
+		for _, i := range set.Intervals() {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the disjoint intervals of this set in ascending order
+func (set *IPSet) All() iter.Seq[*IPInterval] {
+	return func(yield func(*IPInterval) bool) {
+		for _, i := range set.Intervals() {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
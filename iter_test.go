@@ -0,0 +1,105 @@
+package interval
+
+import (
+	"math"
+	"net/netip"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStep(t *testing.T) {
+	tests := []struct {
+		name string
+		bi   *BaseInterval[int64]
+		step int64
+		want []int64
+	}{
+		{name: "closed", bi: NewBaseInterval[int64](0, 10, Closed), step: 5, want: []int64{0, 5, 10}},
+		{name: "closedOpen", bi: NewBaseInterval[int64](0, 10, ClosedOpen), step: 5, want: []int64{0, 5}},
+		{name: "openClosed", bi: NewBaseInterval[int64](0, 10, OpenClosed), step: 5, want: []int64{5, 10}},
+		{name: "open", bi: NewBaseInterval[int64](0, 10, Open), step: 3, want: []int64{3, 6, 9}},
+		{name: "zeroStep", bi: NewBaseInterval[int64](0, 10, Closed), step: 0, want: nil},
+		{name: "overflow", bi: NewBaseInterval[int64](math.MaxInt64-3, math.MaxInt64, Closed), step: 2, want: []int64{math.MaxInt64 - 3, math.MaxInt64 - 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.Collect(Step(tt.bi, tt.step)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Step() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	var got []float64
+	for v := range Step(NewBaseInterval[float64](0, 1, Closed), 0.1) {
+		got = append(got, v)
+	}
+	if len(got) != 11 || got[10] != 1 {
+		t.Errorf("Step() = %v, want 11 values ending with 1", got)
+	}
+	for _, bi := range []*BaseInterval[float64]{
+		NewBaseIntervalFromBounds(UnboundedBound[float64](), IncludedBound(1.0)),
+		NewBaseInterval(math.Inf(-1), 1, Closed),
+	} {
+		if got := slices.Collect(Step(bi, 0.5)); len(got) != 0 {
+			t.Errorf("Step(%v) = %v, want nothing", bi, got)
+		}
+	}
+}
+
+func TestTimeInterval_Step(t *testing.T) {
+	d1 := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	d3 := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+	got := slices.Collect(NewTimeInterval(d1, d3, OpenClosed).Step(24 * time.Hour))
+	want := []time.Time{d1.Add(24 * time.Hour), d3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Step() = %v, want %v", got, want)
+	}
+}
+
+func TestBaseOverlay_All(t *testing.T) {
+	o := NewBaseOverlay[int](func(acc, v string) string { return acc + v })
+	o.Add(NewBaseInterval(0, 10), "a")
+	o.Add(NewBaseInterval(5, 15), "b")
+	var values []string
+	for seg := range o.All() {
+		values = append(values, seg.Value)
+		if len(values) == 2 {
+			break
+		}
+	}
+	if want := []string{"a", "ab"}; !reflect.DeepEqual(values, want) {
+		t.Errorf("All() = %v, want %v", values, want)
+	}
+}
+
+func TestSet_All(t *testing.T) {
+	bs := NewBaseIntervalSet(NewBaseInterval(5, 8), NewBaseInterval(0, 2))
+	var got []string
+	for bi := range bs.All() {
+		got = append(got, bi.String())
+	}
+	if want := []string{"[0,2)", "[5,8)"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("BaseIntervalSet.All() = %v, want %v", got, want)
+	}
+
+	is := NewIntervalSet(NewInterval(SemVer{Major: 2}, SemVer{Major: 3}), NewInterval(SemVer{Major: 1}, SemVer{Major: 1, Minor: 5}))
+	if got := slices.Collect(is.All()); !reflect.DeepEqual(got, is.Intervals()) {
+		t.Errorf("IntervalSet.All() = %v, want %v", got, is.Intervals())
+	}
+
+	cs := NewCmpIntervalSet(strings.Compare, NewCmpInterval(strings.Compare, "a", "c"), NewCmpInterval(strings.Compare, "x", "z"))
+	for ci := range cs.All() {
+		if ci.String() != "[a,c)" {
+			t.Errorf("CmpIntervalSet.All() yields %v first", ci)
+		}
+		break
+	}
+
+	ips := NewIPSet(NewPrefixInterval(netip.MustParsePrefix("10.0.1.0/24")), NewPrefixInterval(netip.MustParsePrefix("10.0.0.0/24")))
+	if got := slices.Collect(ips.All()); len(got) != 1 || got[0].String() != "[10.0.0.0,10.0.1.255]" {
+		t.Errorf("IPSet.All() = %v", got)
+	}
+}
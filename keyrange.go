@@ -0,0 +1,148 @@
+package interval
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+)
+
+// KeyRange is a range of byte slice keys [start,end) ordered by bytes.Compare, as scanned in key value stores.
+// An empty start is the lowest key and an empty end means the range is unbounded. Keys are not copied
+type KeyRange struct {
+	start []byte
+	end   []byte
+}
+
+// NewKeyRange returns a new KeyRange
+func NewKeyRange(start, end []byte) *KeyRange {
+	return &KeyRange{start: start, end: end}
+}
+
+// PrefixRange returns the range of all keys with the given prefix
+func PrefixRange(prefix []byte) *KeyRange {
+	return NewKeyRange(prefix, prefixEnd(prefix))
+}
+
+// prefixEnd returns the lowest key greater than all keys with the prefix, empty if there is none
+func prefixEnd(prefix []byte) []byte {
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] != 0xff {
+			end := append([]byte{}, prefix[:i+1]...)
+			end[i]++
+			return end
+		}
+	}
+	return nil
+}
+
+// Start returns the inclusive start key of this range
+func (kr *KeyRange) Start() []byte {
+	return kr.start
+}
+
+// End returns the exclusive end key of this range, empty if it is unbounded
+func (kr *KeyRange) End() []byte {
+	return kr.end
+}
+
+// Unbounded returns true if this range has no end
+func (kr *KeyRange) Unbounded() bool {
+	return len(kr.end) == 0
+}
+
+// IsEmpty returns true if this range contains no key
+func (kr *KeyRange) IsEmpty() bool {
+	return !kr.Unbounded() && bytes.Compare(kr.start, kr.end) >= 0
+}
+
+// Contains returns true if the given key is in this range
+func (kr *KeyRange) Contains(key []byte) bool {
+	return bytes.Compare(key, kr.start) >= 0 && (kr.Unbounded() || bytes.Compare(key, kr.end) < 0)
+}
+
+// Overlaps returns true if this range and other have a key in common
+func (kr *KeyRange) Overlaps(other *KeyRange) bool {
+	return !kr.Intersect(other).IsEmpty()
+}
+
+// Intersect returns the range of keys in both this range and other, it may be empty
+func (kr *KeyRange) Intersect(other *KeyRange) *KeyRange {
+	start, end := kr.start, kr.end
+	if bytes.Compare(other.start, start) > 0 {
+		start = other.start
+	}
+	if compareKeyRangeEnd(other.end, end) < 0 {
+		end = other.end
+	}
+	return NewKeyRange(start, end)
+}
+
+// Split splits this range at the given keys, keys out of the range or equal to its start are ignored
+func (kr *KeyRange) Split(keys ...[]byte) []*KeyRange {
+	sorted := append([][]byte{}, keys...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i], sorted[j]) < 0
+	})
+	ranges := []*KeyRange{}
+	start := kr.start
+	for _, key := range sorted {
+		if bytes.Compare(key, start) <= 0 || !kr.Contains(key) {
+			continue
+		}
+		ranges = append(ranges, NewKeyRange(start, key))
+		start = key
+	}
+	return append(ranges, NewKeyRange(start, kr.end))
+}
+
+// String returns a readable string of this range like ["a","b"), an unbounded end is NULL
+func (kr *KeyRange) String() string {
+	bs := &bytes.Buffer{}
+	bs.WriteString(LeftClosed)
+	bs.WriteString(strconv.Quote(string(kr.start)))
+	bs.WriteString(Spacer)
+	if kr.Unbounded() {
+		bs.WriteString(NullFlag)
+	} else {
+		bs.WriteString(strconv.Quote(string(kr.end)))
+	}
+	bs.WriteString(RightOpen)
+	return bs.String()
+}
+
+// compareKeyRangeEnd compares two end keys, the empty one is unbounded and greater than the others
+func compareKeyRangeEnd(a, b []byte) int {
+	switch {
+	case len(a) == 0 && len(b) == 0:
+		return 0
+	case len(a) == 0:
+		return 1
+	case len(b) == 0:
+		return -1
+	}
+	return bytes.Compare(a, b)
+}
+
+// MergeKeyRanges sorts the ranges and merges the overlapping and adjacent ones, empty ranges are dropped
+func MergeKeyRanges(ranges []*KeyRange) []*KeyRange {
+	sorted := make([]*KeyRange, 0, len(ranges))
+	for _, kr := range ranges {
+		if !kr.IsEmpty() {
+			sorted = append(sorted, kr)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].start, sorted[j].start) < 0
+	})
+	var merged []*KeyRange
+	for _, kr := range sorted {
+		if n := len(merged); n > 0 && (merged[n-1].Unbounded() || bytes.Compare(kr.start, merged[n-1].end) <= 0) {
+			if compareKeyRangeEnd(kr.end, merged[n-1].end) > 0 {
+				merged[n-1] = NewKeyRange(merged[n-1].start, kr.end)
+			}
+			continue
+		}
+		merged = append(merged, kr)
+	}
+	return merged
+}
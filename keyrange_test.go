@@ -0,0 +1,75 @@
+package interval
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPrefixRange(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix []byte
+		want   *KeyRange
+	}{
+		{name: "plain", prefix: []byte("user/"), want: NewKeyRange([]byte("user/"), []byte("user0"))},
+		{name: "trailingFF", prefix: []byte{'a', 0xff, 0xff}, want: NewKeyRange([]byte{'a', 0xff, 0xff}, []byte{'b'})},
+		{name: "allFF", prefix: []byte{0xff}, want: NewKeyRange([]byte{0xff}, nil)},
+		{name: "empty", prefix: []byte{}, want: NewKeyRange([]byte{}, nil)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PrefixRange(tt.prefix); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PrefixRange() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	users := PrefixRange([]byte("user/"))
+	for key, want := range map[string]bool{"user/": true, "user/1": true, "user0": false, "user": false, "usex": false} {
+		if got := users.Contains([]byte(key)); got != want {
+			t.Errorf("Contains(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
+
+func TestKeyRange_Split(t *testing.T) {
+	kr := NewKeyRange([]byte("b"), nil)
+	got := kr.Split([]byte("d"), []byte("a"), []byte("c"), []byte("b"), []byte("c"))
+	want := []*KeyRange{
+		NewKeyRange([]byte("b"), []byte("c")),
+		NewKeyRange([]byte("c"), []byte("d")),
+		NewKeyRange([]byte("d"), nil),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Split() = %v, want %v", got, want)
+	}
+	if got := MergeKeyRanges(got); !reflect.DeepEqual(got, []*KeyRange{kr}) {
+		t.Errorf("MergeKeyRanges() = %v, want %v", got, kr)
+	}
+}
+
+func TestMergeKeyRanges(t *testing.T) {
+	got := MergeKeyRanges([]*KeyRange{
+		NewKeyRange([]byte("m"), []byte("p")),
+		NewKeyRange([]byte("a"), []byte("c")),
+		NewKeyRange([]byte("x"), []byte("x")),
+		NewKeyRange([]byte("b"), []byte("d")),
+		NewKeyRange([]byte("o"), []byte("q")),
+	})
+	want := []*KeyRange{NewKeyRange([]byte("a"), []byte("d")), NewKeyRange([]byte("m"), []byte("q"))}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeKeyRanges() = %v, want %v", got, want)
+	}
+}
+
+func TestKeyRange_Intersect(t *testing.T) {
+	a, b := NewKeyRange([]byte("a"), []byte("m")), NewKeyRange([]byte("k"), nil)
+	if got, want := a.Intersect(b), NewKeyRange([]byte("k"), []byte("m")); !reflect.DeepEqual(got, want) {
+		t.Errorf("Intersect() = %v, want %v", got, want)
+	}
+	if a.Overlaps(NewKeyRange([]byte("m"), nil)) {
+		t.Errorf("Overlaps() = true, want false")
+	}
+	if got := b.String(); got != `["k",NULL)` {
+		t.Errorf("String() = %v", got)
+	}
+}
@@ -0,0 +1,240 @@
+package interval
+
+import (
+	"math"
+	"time"
+)
+
+// The metrics measure intervals as continuous ranges, the length of an interval is its right value
+// minus its left value whatever its open closed type, so integer intervals are not counted member by member.
+// An interval with an Unbounded end has an infinite length, an empty interval has length 0 and is
+// infinitely far from everything. Ratios of two infinite lengths are NaN
+
+// length returns the length of b, sub returns b-a of two values
+func (b *bounds[T]) length(cmp func(a, b T) int, sub func(a, b T) float64) float64 {
+	switch {
+	case b.isEmpty(cmp):
+		return 0
+	case b.lower.kind == Unbounded || b.upper.kind == Unbounded:
+		return math.Inf(1)
+	}
+	return sub(b.lower.value, b.upper.value)
+}
+
+// intersect returns the bounds of the values in both b and o, they may be empty
+func (b *bounds[T]) intersect(cmp func(a, b T) int, o *bounds[T]) bounds[T] {
+	i := *b
+	if compareLower(cmp, o.lower, i.lower) > 0 {
+		i.lower = o.lower
+	}
+	if compareUpper(cmp, o.upper, i.upper) < 0 {
+		i.upper = o.upper
+	}
+	return i
+}
+
+// isEmpty returns true if no value is between the bounds
+func (b *bounds[T]) isEmpty(cmp func(a, b T) int) bool {
+	return compareLowerUpper(cmp, b.lower, b.upper) >= 0
+}
+
+func (b *bounds[T]) pointDistance(cmp func(a, b T) int, sub func(a, b T) float64, v T) float64 {
+	switch {
+	case b.isEmpty(cmp):
+		return math.Inf(1)
+	case b.lower.kind != Unbounded && cmp(v, b.lower.value) < 0:
+		return sub(v, b.lower.value)
+	case b.upper.kind != Unbounded && cmp(v, b.upper.value) > 0:
+		return sub(b.upper.value, v)
+	}
+	return 0
+}
+
+func (b *bounds[T]) distance(cmp func(a, b T) int, sub func(a, b T) float64, o *bounds[T]) float64 {
+	if b.isEmpty(cmp) || o.isEmpty(cmp) {
+		return math.Inf(1)
+	}
+	g, ok := b.gap(cmp, o)
+	if !ok {
+		return 0
+	}
+	return sub(g.lower.value, g.upper.value)
+}
+
+func (b *bounds[T]) overlap(cmp func(a, b T) int, sub func(a, b T) float64, o *bounds[T]) float64 {
+	i := b.intersect(cmp, o)
+	return i.length(cmp, sub)
+}
+
+func (b *bounds[T]) jaccard(cmp func(a, b T) int, sub func(a, b T) float64, o *bounds[T]) float64 {
+	i := b.intersect(cmp, o)
+	inter := i.length(cmp, sub)
+	union := b.length(cmp, sub) + o.length(cmp, sub) - inter
+	switch {
+	case math.IsInf(inter, 1):
+		// infinite intervals are alike only if they are equal
+		if b.encloses(cmp, o) && o.encloses(cmp, b) {
+			return 1
+		}
+		return math.NaN()
+	case math.IsInf(union, 1):
+		return 0
+	case union == 0:
+		// points and empty intervals are alike if they share their point or are both empty
+		if !i.isEmpty(cmp) || b.isEmpty(cmp) && o.isEmpty(cmp) {
+			return 1
+		}
+		return 0
+	}
+	return inter / union
+}
+
+func (b *bounds[T]) hausdorff(cmp func(a, b T) int, sub func(a, b T) float64, o *bounds[T]) float64 {
+	be, oe := b.isEmpty(cmp), o.isEmpty(cmp)
+	switch {
+	case be && oe:
+		return 0
+	case be || oe:
+		return math.Inf(1)
+	}
+	side := func(b1, b2 Bound[T]) float64 {
+		switch {
+		case b1.kind == Unbounded && b2.kind == Unbounded:
+			return 0
+		case b1.kind == Unbounded || b2.kind == Unbounded:
+			return math.Inf(1)
+		case cmp(b1.value, b2.value) == 0:
+			// equal infinite float values have no finite difference
+			return 0
+		}
+		return math.Abs(sub(b1.value, b2.value))
+	}
+	return math.Max(side(b.lower, o.lower), side(b.upper, o.upper))
+}
+
+func (b *bounds[T]) containmentRatio(cmp func(a, b T) int, sub func(a, b T) float64, o *bounds[T]) float64 {
+	i := b.intersect(cmp, o)
+	inter, whole := i.length(cmp, sub), b.length(cmp, sub)
+	switch {
+	case math.IsInf(inter, 1):
+		// an infinite b is wholly contained only if o encloses it
+		if o.encloses(cmp, b) {
+			return 1
+		}
+		return math.NaN()
+	case math.IsInf(whole, 1):
+		return 0
+	case whole == 0:
+		// a point is contained or not, an empty interval is contained in anything
+		if b.isEmpty(cmp) || !i.isEmpty(cmp) {
+			return 1
+		}
+		return 0
+	}
+	return inter / whole
+}
+
+func subNumber[T number](a, b T) float64 {
+	return float64(b) - float64(a)
+}
+
+// Length returns the right value minus the left value of the interval, 0 if it is empty and +Inf if it is unbounded
+func Length[T number](bi *BaseInterval[T]) float64 {
+	return bi.length(compareBase[T], subNumber[T])
+}
+
+// Distance returns the distance from v to the nearest point of the interval, 0 if v is contained
+// or is an open end, +Inf if the interval is empty and NaN if v is NaN
+func Distance[T number](bi *BaseInterval[T], v T) float64 {
+	if v != v {
+		return math.NaN()
+	}
+	return bi.pointDistance(compareBase[T], subNumber[T], v)
+}
+
+// IntervalDistance returns the length of the gap between a and b, 0 if they overlap or touch
+// like "[1,2)" and "(2,3]", +Inf if one is empty
+func IntervalDistance[T number](a, b *BaseInterval[T]) float64 {
+	return a.distance(compareBase[T], subNumber[T], &b.bounds)
+}
+
+// OverlapLength returns the length of the intersection of a and b, +Inf if it is unbounded
+func OverlapLength[T number](a, b *BaseInterval[T]) float64 {
+	return a.overlap(compareBase[T], subNumber[T], &b.bounds)
+}
+
+// Jaccard returns the length of the intersection of a and b divided by the length of their union.
+// Two intervals of length 0 give 1 if they share a point or are both empty and 0 otherwise,
+// an infinite union gives 0 unless the intersection is also infinite, which gives 1 for equal intervals and NaN otherwise
+func Jaccard[T number](a, b *BaseInterval[T]) float64 {
+	return a.jaccard(compareBase[T], subNumber[T], &b.bounds)
+}
+
+// Hausdorff returns the largest distance from a point of one interval to the other one, which is
+// the larger distance between the left values and between the right values. It is 0 for two empty intervals,
+// +Inf if only one is empty or only one end of a side is Unbounded
+func Hausdorff[T number](a, b *BaseInterval[T]) float64 {
+	return a.hausdorff(compareBase[T], subNumber[T], &b.bounds)
+}
+
+// ContainmentRatio returns the share of the length of a which is in b. An a of length 0 gives 1 if b contains it
+// or it is empty and 0 otherwise, an unbounded a gives 0 unless the intersection is also infinite, which gives 1
+// if b encloses a and NaN otherwise
+func ContainmentRatio[T number](a, b *BaseInterval[T]) float64 {
+	return a.containmentRatio(compareBase[T], subNumber[T], &b.bounds)
+}
+
+// subTime returns b minus a in nanoseconds, computed from the seconds as b.Sub(a) saturates beyond about 292 years
+func subTime(a, b time.Time) float64 {
+	return float64(b.Unix()-a.Unix())*float64(time.Second) + float64(b.Nanosecond()-a.Nanosecond())
+}
+
+// duration converts a float number of nanoseconds to a Duration, the infinity is the largest Duration
+func duration(f float64) time.Duration {
+	if f >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(f)
+}
+
+// Duration returns the right time minus the left time of this interval, 0 if it is empty and
+// the largest Duration if it is unbounded
+func (ti *TimeInterval) Duration() time.Duration {
+	return duration(ti.length(compareTime, subTime))
+}
+
+// Distance returns the duration from t to the nearest time of this interval, 0 if t is contained
+// or is an open end, the largest Duration if this interval is empty
+func (ti *TimeInterval) Distance(t time.Time) time.Duration {
+	return duration(ti.pointDistance(compareTime, subTime, t))
+}
+
+// IntervalDistance returns the duration of the gap between this interval and other, 0 if they overlap or touch,
+// the largest Duration if one is empty
+func (ti *TimeInterval) IntervalDistance(other *TimeInterval) time.Duration {
+	return duration(ti.distance(compareTime, subTime, &other.bounds))
+}
+
+// OverlapDuration returns the duration of the intersection of this interval and other,
+// the largest Duration if it is unbounded
+func (ti *TimeInterval) OverlapDuration(other *TimeInterval) time.Duration {
+	return duration(ti.overlap(compareTime, subTime, &other.bounds))
+}
+
+// Jaccard returns the duration of the intersection divided by the duration of the union of this interval
+// and other, with the same rules for instants, empty and unbounded intervals as the Jaccard function
+func (ti *TimeInterval) Jaccard(other *TimeInterval) float64 {
+	return ti.jaccard(compareTime, subTime, &other.bounds)
+}
+
+// Hausdorff returns the larger duration between the left times and between the right times of this interval
+// and other, with the same rules for empty and unbounded intervals as the Hausdorff function
+func (ti *TimeInterval) Hausdorff(other *TimeInterval) time.Duration {
+	return duration(ti.hausdorff(compareTime, subTime, &other.bounds))
+}
+
+// ContainmentRatio returns the share of the duration of this interval which is in other,
+// with the same rules for instants, empty and unbounded intervals as the ContainmentRatio function
+func (ti *TimeInterval) ContainmentRatio(other *TimeInterval) float64 {
+	return ti.containmentRatio(compareTime, subTime, &other.bounds)
+}
@@ -0,0 +1,117 @@
+package interval
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestMetrics(t *testing.T) {
+	parse := func(str string) *BaseInterval[float64] {
+		bi, err := ParseFloatInterval(str)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return bi
+	}
+	empty := NewBaseInterval(3.0, 3.0, ClosedOpen)
+	tests := []struct {
+		a, b                                               *BaseInterval[float64]
+		distance, overlap, jaccard, hausdorff, containment float64
+	}{
+		{parse("[0,10)"), parse("[5,15]"), 0, 5, 1.0 / 3, 5, 0.5},
+		{parse("[0,2)"), parse("(2,3]"), 0, 0, 0, 2, 0},
+		{parse("[0,1]"), parse("[4,6]"), 3, 0, 0, 5, 0},
+		{parse("[1,1]"), parse("[1,1]"), 0, 0, 1, 0, 1},
+		{parse("[1,1]"), parse("[0,4]"), 0, 0, 0, 3, 1},
+		{empty, empty, math.Inf(1), 0, 1, 0, 1},
+		{empty, parse("[0,4]"), math.Inf(1), 0, 0, math.Inf(1), 1},
+		{parse("[0,+inf)"), parse("[0,10]"), 0, 10, 0, math.Inf(1), 0},
+		{parse("[0,+inf)"), parse("[5,+inf)"), 0, math.Inf(1), math.NaN(), 5, math.NaN()},
+		{parse("[5,+inf)"), parse("[0,+inf)"), 0, math.Inf(1), math.NaN(), 5, 1},
+		{parse("(-inf,+inf)"), parse("(-inf,+inf)"), 0, math.Inf(1), 1, 0, 1},
+	}
+	same := func(got, want float64) bool {
+		return got == want || math.IsNaN(got) && math.IsNaN(want) || math.Abs(got-want) < 1e-12
+	}
+	for _, tt := range tests {
+		if got := IntervalDistance(tt.a, tt.b); !same(got, tt.distance) {
+			t.Errorf("IntervalDistance(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.distance)
+		}
+		if got := OverlapLength(tt.a, tt.b); !same(got, tt.overlap) {
+			t.Errorf("OverlapLength(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.overlap)
+		}
+		if got := Jaccard(tt.a, tt.b); !same(got, tt.jaccard) || !same(Jaccard(tt.b, tt.a), got) {
+			t.Errorf("Jaccard(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.jaccard)
+		}
+		if got := Hausdorff(tt.a, tt.b); !same(got, tt.hausdorff) || !same(Hausdorff(tt.b, tt.a), got) {
+			t.Errorf("Hausdorff(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.hausdorff)
+		}
+		if got := ContainmentRatio(tt.a, tt.b); !same(got, tt.containment) {
+			t.Errorf("ContainmentRatio(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.containment)
+		}
+	}
+
+	bi := NewBaseInterval(1, 5, Open)
+	for v, want := range map[int]float64{-1: 2, 1: 0, 3: 0, 5: 0, 8: 3} {
+		if got := Distance(bi, v); got != want {
+			t.Errorf("Distance(%v, %v) = %v, want %v", bi, v, got, want)
+		}
+	}
+	if got := Distance(NewBaseInterval(1.0, 5.0, Open), math.NaN()); !math.IsNaN(got) {
+		t.Errorf("Distance(NaN) = %v", got)
+	}
+	if got := Length(bi); got != 4 {
+		t.Errorf("Length(%v) = %v", bi, got)
+	}
+	if got := Length(NewBaseIntervalFromBounds(UnboundedBound[int](), IncludedBound(0))); !math.IsInf(got, 1) {
+		t.Errorf("Length() = %v", got)
+	}
+}
+
+func TestTimeInterval_Metrics(t *testing.T) {
+	t1 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := NewTimeInterval(t1, t1.Add(24*time.Hour))
+	night := NewTimeInterval(t1.Add(18*time.Hour), t1.Add(30*time.Hour))
+	if got := day.Duration(); got != 24*time.Hour {
+		t.Errorf("Duration() = %v", got)
+	}
+	if got := day.OverlapDuration(night); got != 6*time.Hour {
+		t.Errorf("OverlapDuration() = %v", got)
+	}
+	if got := day.Jaccard(night); got != 0.2 {
+		t.Errorf("Jaccard() = %v", got)
+	}
+	if got := day.ContainmentRatio(night); got != 0.25 {
+		t.Errorf("ContainmentRatio() = %v", got)
+	}
+	if got := day.Hausdorff(night); got != 18*time.Hour {
+		t.Errorf("Hausdorff() = %v", got)
+	}
+	if got := day.Distance(t1.Add(-time.Hour)); got != time.Hour {
+		t.Errorf("Distance() = %v", got)
+	}
+	if got := day.IntervalDistance(night.Shift(48 * time.Hour)); got != 42*time.Hour {
+		t.Errorf("IntervalDistance() = %v", got)
+	}
+	open := NewTimeIntervalFromBounds(IncludedBound(t1), UnboundedBound[time.Time]())
+	if got := open.Duration(); got != math.MaxInt64 {
+		t.Errorf("Duration() = %v", got)
+	}
+	if got := open.Hausdorff(day); got != math.MaxInt64 {
+		t.Errorf("Hausdorff() = %v", got)
+	}
+
+	// centuries are wider than the largest Duration
+	y := func(year int) time.Time { return time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC) }
+	old, late := NewTimeInterval(y(1000), y(2000)), NewTimeInterval(y(1500), y(2500))
+	if got := old.Jaccard(late); math.Abs(got-1.0/3) > 1e-4 {
+		t.Errorf("Jaccard() = %v", got)
+	}
+	if got := old.ContainmentRatio(late); math.Abs(got-0.5) > 1e-4 {
+		t.Errorf("ContainmentRatio() = %v", got)
+	}
+	if got := old.Duration(); got != math.MaxInt64 {
+		t.Errorf("Duration() = %v", got)
+	}
+}
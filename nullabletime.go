@@ -1,8 +1,6 @@
 package interval
 
 import (
-	"bytes"
-	"strings"
 	"time"
 )
 
@@ -11,128 +9,99 @@ const (
 )
 
 // NullableTimeInterval nullable time interval
+// a nil value is an Unbounded end, which is open whatever the OpenClosedType given for it,
+// so NewNullableTimeInterval(nil, &t, Closed) is "(NULL,t]"
 type NullableTimeInterval struct {
-	left           *time.Time
-	right          *time.Time
-	openClosedType OpenClosedType
+	bounds[time.Time]
 }
 
-// NewNullableTimeInterval return a new NullableTimeInterval
+// NewNullableTimeInterval return a new NullableTimeInterval, a nil end is Unbounded and open
+// whatever openCloseType says for it, so OpenClosedType() and String() show it open
 func NewNullableTimeInterval(left, right *time.Time, openCloseType ...OpenClosedType) *NullableTimeInterval {
-	t := Default
-	if len(openCloseType) > 0 {
-		t = openCloseType[0]
+	var l, r time.Time
+	if left != nil {
+		l = *left
 	}
-	return &NullableTimeInterval{
-		left:           left,
-		right:          right,
-		openClosedType: t,
+	if right != nil {
+		r = *right
 	}
+	ti := &NullableTimeInterval{bounds: newBounds(l, r, openCloseType)}
+	if left == nil {
+		ti.lower = UnboundedBound[time.Time]()
+	}
+	if right == nil {
+		ti.upper = UnboundedBound[time.Time]()
+	}
+	return ti
 }
 
 // ParseNullableTimeInterval parse str to interval
 func ParseNullableTimeInterval(intervalStr string, layout ...string) (ti *NullableTimeInterval, err error) {
-	var lf, lv, rv, rf string
-	if lf, lv, rv, rf, err = blowUp(intervalStr); err != nil {
-		return
-	}
+	return DefaultGrammar.ParseNullableTimeInterval(intervalStr, layout...)
+}
+
+// ParseNullableTimeInterval parse str to interval with this grammar, a null token is parsed to a nil value,
+// which is an open end, so "[NULL,2023-01-02T00:00:00Z]" is parsed to "(NULL,2023-01-02T00:00:00Z]"
+func (g *Grammar) ParseNullableTimeInterval(intervalStr string, layout ...string) (ti *NullableTimeInterval, err error) {
 	var openClosedType OpenClosedType
-	if openClosedType, err = getOpenClosedType(lf, rf); err != nil {
-		return nil, err
+	var lv, rv string
+	if openClosedType, lv, rv, err = g.blowUp(intervalStr); err != nil {
+		return
 	}
 	l := defaultTimeLayout
 	if len(layout) > 0 {
 		l = layout[0]
 	}
 	var lt, rt *time.Time
-	if lt, err = parseNullableTimeStr(l, lv); err != nil {
+	if lt, err = g.parseNullableTimeStr(l, lv, -1); err != nil {
 		return nil, err
 	}
-	if rt, err = parseNullableTimeStr(l, rv); err != nil {
+	if rt, err = g.parseNullableTimeStr(l, rv, 1); err != nil {
 		return nil, err
 	}
 	return NewNullableTimeInterval(lt, rt, openClosedType), nil
 }
 
-// Left returns the left value of this interval
+// Left returns a copy of the left value of this interval, nil if it is Unbounded
 func (ti *NullableTimeInterval) Left() *time.Time {
-	return ti.left
+	if v, ok := ti.lower.Value(); ok {
+		return &v
+	}
+	return nil
 }
 
-// Right returns the right value of this interval
+// Right returns a copy of the right value of this interval, nil if it is Unbounded
 func (ti *NullableTimeInterval) Right() *time.Time {
-	return ti.right
+	if v, ok := ti.upper.Value(); ok {
+		return &v
+	}
+	return nil
 }
 
-// OpenClosedType returns the OpenClosedType of this interval
-func (ti *NullableTimeInterval) OpenClosedType() OpenClosedType {
-	return ti.openClosedType
+// Contains return ture if this interval contains the given element
+// null element is out of any interval, a nil end contains every time on its side,
+// so "(NULL,NULL)" contains every non null time
+func (ti *NullableTimeInterval) Contains(e *time.Time) bool {
+	return e != nil && ti.contains(compareTime, *e)
 }
 
-// LeftClosed returns true if interval is a left-closed interval
-func (ti *NullableTimeInterval) LeftClosed() bool {
-	return ti.openClosedType&ClosedOpen == ClosedOpen
+// String returns a readable string of this interval with the times in RFC3339
+func (ti *NullableTimeInterval) String() string {
+	return ti.FormatLayout(defaultTimeLayout)
 }
 
-// RightClosed returns true if interval is a right-closed interval
-func (ti *NullableTimeInterval) RightClosed() bool {
-	return ti.openClosedType&OpenClosed == OpenClosed
+// FormatLayout returns a readable string of this interval with the times in the given layout
+func (ti *NullableTimeInterval) FormatLayout(layout string) string {
+	return ti.string(func(t time.Time) string { return t.Format(layout) }, NullFlag, NullFlag)
 }
 
-// Contains return ture if this interval contains the given element
-// null element is out of any interval
-func (ti *NullableTimeInterval) Contains(e *time.Time) bool {
-	if e == nil {
-		return false
+// parseNullableTimeStr parse the value of the end on side, -1 for the left and 1 for the right,
+// a null or the infinity of that side is a nil time
+func (g *Grammar) parseNullableTimeStr(layout, value string, side int) (*time.Time, error) {
+	if sign := g.infinity(value); sign != 0 && sign != side {
+		return nil, ValueStrErr
 	}
-	switch {
-	case ti.left != nil && ti.right != nil:
-		return (e.After(*ti.left) && e.Before(*ti.right)) ||
-			(e.Equal(*ti.left) && ti.openClosedType&ClosedOpen == ClosedOpen) ||
-			(e.Equal(*ti.right) && ti.openClosedType&OpenClosed == OpenClosed)
-	case ti.left == nil && ti.right == nil:
-		return false
-	case ti.left == nil:
-		return (e.Before(*ti.right)) || (e.Equal(*ti.right) && ti.openClosedType&OpenClosed == OpenClosed)
-	case ti.right == nil:
-		return (e.After(*ti.left)) || (e.Equal(*ti.left) && ti.openClosedType&ClosedOpen == ClosedOpen)
-	}
-	return false
-}
-
-// String returns a readable string of this interval
-func (ti *NullableTimeInterval) String(layout ...string) string {
-	l := defaultTimeLayout
-	if len(layout) > 0 {
-		l = layout[0]
-	}
-	bs := &bytes.Buffer{}
-	if ti.LeftClosed() {
-		bs.WriteString(LeftClosed)
-	} else {
-		bs.WriteString(LeftOpen)
-	}
-	if ti.left != nil {
-		bs.WriteString(ti.left.Format(l))
-	} else {
-		bs.WriteString(NullFlag)
-	}
-	bs.WriteString(Spacer)
-	if ti.right != nil {
-		bs.WriteString(ti.right.Format(l))
-	} else {
-		bs.WriteString(NullFlag)
-	}
-	if ti.RightClosed() {
-		bs.WriteString(RightClosed)
-	} else {
-		bs.WriteString(RightOpen)
-	}
-	return bs.String()
-}
-
-func parseNullableTimeStr(layout, value string) (*time.Time, error) {
-	if strings.ToUpper(value) == NullFlag {
+	if g.isNull(value) || g.infinity(value) != 0 {
 		return nil, nil
 	}
 	if t, err := time.Parse(layout, value); err != nil {
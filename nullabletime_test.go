@@ -172,3 +172,24 @@ func TestNullableTimeInterval_Contains(t *testing.T) {
 		})
 	}
 }
+
+func TestNullableTimeInterval_NilEnds(t *testing.T) {
+	t1 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ti := NewNullableTimeInterval(nil, &t1, Closed)
+	if ti.LeftClosed() || !ti.RightClosed() || ti.OpenClosedType() != OpenClosed {
+		t.Errorf("%v has type %v", ti, ti.OpenClosedType())
+	}
+	if got, want := ti.String(), "(NULL,2023-01-01T00:00:00Z]"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+	if r := ti.Right(); r == &t1 || !r.Equal(t1) {
+		t.Errorf("Right() = %v is not a copy", r)
+	}
+	if pi, err := ParseNullableTimeInterval("[NULL,2023-01-01T00:00:00Z]"); err != nil || pi.String() != "(NULL,2023-01-01T00:00:00Z]" {
+		t.Errorf("ParseNullableTimeInterval() = %v, %v", pi, err)
+	}
+	all := NewNullableTimeInterval(nil, nil, Closed)
+	if !all.Contains(&t1) || all.Contains(nil) || all.OpenClosedType() != Open {
+		t.Errorf("%v has wrong members", all)
+	}
+}
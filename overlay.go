@@ -0,0 +1,147 @@
+package interval
+
+import (
+	"sort"
+	"time"
+)
+
+// overlayEntry is an interval added to an overlay with its value
+type overlayEntry[P, V any] struct {
+	lower cut[P]
+	upper cut[P]
+	value V
+}
+
+// segment is an elementary piece of an overlay with its aggregated value
+type segment[P, V any] struct {
+	lower cut[P]
+	upper cut[P]
+	value V
+}
+
+// overlay is the implementation shared by BaseOverlay and TimeOverlay
+type overlay[P, V any] struct {
+	cmp     func(a, b P) int
+	combine func(acc, v V) V
+	entries []overlayEntry[P, V]
+}
+
+func (o *overlay[P, V]) add(lower, upper cut[P], v V) {
+	// empty intervals cover nothing
+	if compareCut(o.cmp, lower, upper) >= 0 {
+		return
+	}
+	o.entries = append(o.entries, overlayEntry[P, V]{lower: lower, upper: upper, value: v})
+}
+
+func (o *overlay[P, V]) segments() []segment[P, V] {
+	cuts := make([]cut[P], 0, len(o.entries)*2)
+	for _, e := range o.entries {
+		cuts = append(cuts, e.lower, e.upper)
+	}
+	sort.Slice(cuts, func(i, j int) bool {
+		return compareCut(o.cmp, cuts[i], cuts[j]) < 0
+	})
+	n := 0
+	for _, c := range cuts {
+		if n == 0 || compareCut(o.cmp, cuts[n-1], c) != 0 {
+			cuts[n] = c
+			n++
+		}
+	}
+	cuts = cuts[:n]
+
+	var segments []segment[P, V]
+	for i := 0; i+1 < len(cuts); i++ {
+		lower, upper := cuts[i], cuts[i+1]
+		var acc V
+		covered := false
+		// values are combined in the order they were added
+		for _, e := range o.entries {
+			if compareCut(o.cmp, e.lower, lower) > 0 || compareCut(o.cmp, upper, e.upper) > 0 {
+				continue
+			}
+			if covered {
+				acc = o.combine(acc, e.value)
+			} else {
+				acc, covered = e.value, true
+			}
+		}
+		if covered {
+			segments = append(segments, segment[P, V]{lower: lower, upper: upper, value: acc})
+		}
+	}
+	return segments
+}
+
+// BaseSegment is a disjoint piece of a BaseOverlay with the aggregated value of the intervals covering it
+type BaseSegment[T baseSortable, V any] struct {
+	Interval *BaseInterval[T]
+	Value    V
+}
+
+// BaseOverlay aggregates the values of overlapping BaseInterval
+type BaseOverlay[T baseSortable, V any] struct {
+	o overlay[T, V]
+}
+
+// NewBaseOverlay returns a new BaseOverlay,
+// combine is called with the accumulated value and the value of the next interval covering a segment
+func NewBaseOverlay[T baseSortable, V any](combine func(acc, v V) V) *BaseOverlay[T, V] {
+	return &BaseOverlay[T, V]{o: overlay[T, V]{cmp: compareBase[T], combine: combine}}
+}
+
+// Add adds an interval with its value, empty intervals are ignored
+func (bo *BaseOverlay[T, V]) Add(i *BaseInterval[T], v V) {
+	lower, upper := i.cuts()
+	bo.o.add(lower, upper, v)
+}
+
+// Segments returns the elementary disjoint segments covered by at least one interval in ascending order
+func (bo *BaseOverlay[T, V]) Segments() []BaseSegment[T, V] {
+	segments := bo.o.segments()
+	result := make([]BaseSegment[T, V], 0, len(segments))
+	for _, s := range segments {
+		result = append(result, BaseSegment[T, V]{
+			Interval: newBaseIntervalCuts(s.lower, s.upper),
+			Value:    s.value,
+		})
+	}
+	return result
+}
+
+// TimeSegment is a disjoint piece of a TimeOverlay with the aggregated value of the intervals covering it
+type TimeSegment[V any] struct {
+	Interval *TimeInterval
+	Value    V
+}
+
+// TimeOverlay aggregates the values of overlapping TimeInterval
+type TimeOverlay[V any] struct {
+	o overlay[time.Time, V]
+}
+
+// NewTimeOverlay returns a new TimeOverlay,
+// combine is called with the accumulated value and the value of the next interval covering a segment
+func NewTimeOverlay[V any](combine func(acc, v V) V) *TimeOverlay[V] {
+	return &TimeOverlay[V]{o: overlay[time.Time, V]{cmp: compareTime, combine: combine}}
+}
+
+// Add adds an interval with its value, empty intervals are ignored
+func (to *TimeOverlay[V]) Add(ti *TimeInterval, v V) {
+	lower, upper := ti.cuts()
+	to.o.add(lower, upper, v)
+}
+
+// Segments returns the elementary disjoint segments covered by at least one interval in ascending order
+func (to *TimeOverlay[V]) Segments() []TimeSegment[V] {
+	segments := to.o.segments()
+	result := make([]TimeSegment[V], 0, len(segments))
+	for _, s := range segments {
+		result = append(result, TimeSegment[V]{
+			Interval: newTimeIntervalCuts(s.lower, s.upper),
+			Value:    s.value,
+		})
+	}
+	return result
+}
@@ -0,0 +1,88 @@
+package interval
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBaseOverlay_Segments(t *testing.T) {
+	sum := func(acc, v int) int { return acc + v }
+	tests := []struct {
+		name      string
+		intervals []*BaseInterval[int64]
+		values    []int
+		want      []BaseSegment[int64, int]
+	}{
+		{
+			name:      "disjoint",
+			intervals: []*BaseInterval[int64]{NewBaseInterval[int64](1, 2, Closed), NewBaseInterval[int64](5, 6)},
+			values:    []int{1, 2},
+			want: []BaseSegment[int64, int]{
+				{Interval: NewBaseInterval[int64](1, 2, Closed), Value: 1},
+				{Interval: NewBaseInterval[int64](5, 6, ClosedOpen), Value: 2},
+			},
+		},
+		{
+			name:      "overlap",
+			intervals: []*BaseInterval[int64]{NewBaseInterval[int64](0, 10), NewBaseInterval[int64](5, 15)},
+			values:    []int{1, 2},
+			want: []BaseSegment[int64, int]{
+				{Interval: NewBaseInterval[int64](0, 5, ClosedOpen), Value: 1},
+				{Interval: NewBaseInterval[int64](5, 10, ClosedOpen), Value: 3},
+				{Interval: NewBaseInterval[int64](10, 15, ClosedOpen), Value: 2},
+			},
+		},
+		{
+			name:      "touchClosed",
+			intervals: []*BaseInterval[int64]{NewBaseInterval[int64](0, 5, Closed), NewBaseInterval[int64](5, 10, Closed)},
+			values:    []int{1, 2},
+			want: []BaseSegment[int64, int]{
+				{Interval: NewBaseInterval[int64](0, 5, ClosedOpen), Value: 1},
+				{Interval: NewBaseInterval[int64](5, 5, Closed), Value: 3},
+				{Interval: NewBaseInterval[int64](5, 10, OpenClosed), Value: 2},
+			},
+		},
+		{
+			name:      "empty",
+			intervals: []*BaseInterval[int64]{NewBaseInterval[int64](5, 5, Open), NewBaseInterval[int64](0, 1, Closed)},
+			values:    []int{1, 2},
+			want: []BaseSegment[int64, int]{
+				{Interval: NewBaseInterval[int64](0, 1, Closed), Value: 2},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewBaseOverlay[int64](sum)
+			for i, bi := range tt.intervals {
+				o.Add(bi, tt.values[i])
+			}
+			if got := o.Segments(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Segments() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeOverlay_Segments(t *testing.T) {
+	max := func(acc, v float64) float64 {
+		if v > acc {
+			return v
+		}
+		return acc
+	}
+	d1 := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	d2 := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	d3 := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC)
+	o := NewTimeOverlay[float64](max)
+	o.Add(NewTimeInterval(d1, d3), 0.1)
+	o.Add(NewTimeInterval(d2, d3), 0.2)
+	want := []TimeSegment[float64]{
+		{Interval: NewTimeInterval(d1, d2, ClosedOpen), Value: 0.1},
+		{Interval: NewTimeInterval(d2, d3, ClosedOpen), Value: 0.2},
+	}
+	if got := o.Segments(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Segments() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,188 @@
+package interval
+
+import (
+	"math/big"
+	"math/bits"
+	"time"
+)
+
+// The partition functions split an interval into contiguous pieces which exactly tile it: the first piece
+// keeps the left end of the interval, the last piece keeps its right end and all other ends are ClosedOpen.
+// Empty pieces are never returned, so there may be fewer pieces than asked for small intervals.
+
+// Partition splits an integer interval into n pieces whose numbers of members differ by at most one
+func Partition[T integer](bi *BaseInterval[T], n int) []*BaseInterval[T] {
+	first, last, ok := integerDomain[T]().members(bi.values())
+	if !ok || n <= 0 {
+		return nil
+	}
+	// the k-th boundary is first + k*(last-first+1)/n computed in 128 bits
+	w := uint64(last) - uint64(first)
+	var pieces []*BaseInterval[T]
+	lower, start := bi.lower, first
+	for k := uint64(1); k < uint64(n); k++ {
+		hi, lo := bits.Mul64(k, w)
+		lo, carry := bits.Add64(lo, k, 0)
+		q, _ := bits.Div64(hi+carry, lo, uint64(n))
+		b := first + T(q)
+		if b == start {
+			continue
+		}
+		pieces = append(pieces, NewBaseIntervalFromBounds(lower, ExcludedBound(b)))
+		lower, start = IncludedBound(b), b
+	}
+	return append(pieces, NewBaseIntervalFromBounds(lower, bi.upper))
+}
+
+// PartitionWidth splits an integer interval into pieces of width members starting from its first member,
+// the last piece may be narrower
+func PartitionWidth[T integer](bi *BaseInterval[T], width T) []*BaseInterval[T] {
+	first, last, ok := integerDomain[T]().members(bi.values())
+	if !ok || width <= 0 {
+		return nil
+	}
+	var pieces []*BaseInterval[T]
+	lower := bi.lower
+	// a bound not greater than its predecessor has overflowed
+	for b := first + width; b > first && b <= last; b += width {
+		pieces = append(pieces, NewBaseIntervalFromBounds(lower, ExcludedBound(b)))
+		lower, first = IncludedBound(b), b
+	}
+	return append(pieces, NewBaseIntervalFromBounds(lower, bi.upper))
+}
+
+// isFinite returns false for infinite and NaN values
+func isFinite[T float](v T) bool {
+	return v-v == 0
+}
+
+// isEmptyFloat returns true if the float interval has no member
+func isEmptyFloat[T float](bi *BaseInterval[T]) bool {
+	l, r := bi.ends()
+	return !(l < r || l == r && bi.OpenClosedType() == Closed)
+}
+
+// PartitionFloat splits a float interval into n pieces of equal width, an unbounded interval is not split
+func PartitionFloat[T float](bi *BaseInterval[T], n int) []*BaseInterval[T] {
+	if n <= 0 || isEmptyFloat(bi) {
+		return nil
+	}
+	l, r := bi.ends()
+	if !isFinite(l) || !isFinite(r) {
+		return []*BaseInterval[T]{NewBaseIntervalFromBounds(bi.lower, bi.upper)}
+	}
+	var pieces []*BaseInterval[T]
+	lower, left := bi.lower, l
+	for k := 1; k < n; k++ {
+		// weighting both ends avoids the overflow of right-left
+		b := l*(T(n-k)/T(n)) + r*(T(k)/T(n))
+		if !(b > left && b < r) {
+			continue
+		}
+		pieces = append(pieces, NewBaseIntervalFromBounds(lower, ExcludedBound(b)))
+		lower, left = IncludedBound(b), b
+	}
+	return append(pieces, NewBaseIntervalFromBounds(lower, bi.upper))
+}
+
+// PartitionFloatWidth splits a float interval into pieces of the given width starting from its left end,
+// the last piece may be narrower and an unbounded interval is not split
+func PartitionFloatWidth[T float](bi *BaseInterval[T], width T) []*BaseInterval[T] {
+	if !(width > 0) || isEmptyFloat(bi) {
+		return nil
+	}
+	l, r := bi.ends()
+	if !isFinite(l) || !isFinite(r) {
+		return []*BaseInterval[T]{NewBaseIntervalFromBounds(bi.lower, bi.upper)}
+	}
+	var pieces []*BaseInterval[T]
+	lower, left := bi.lower, l
+	for k := 1; ; k++ {
+		b := l + T(k)*width
+		if !(b > left && b < r) {
+			break
+		}
+		pieces = append(pieces, NewBaseIntervalFromBounds(lower, ExcludedBound(b)))
+		lower, left = IncludedBound(b), b
+	}
+	return append(pieces, NewBaseIntervalFromBounds(lower, bi.upper))
+}
+
+// Partition splits this interval into n pieces of equal duration, an unbounded interval is not split
+func (ti *TimeInterval) Partition(n int) []*TimeInterval {
+	if n <= 0 || compareLowerUpper(compareTime, ti.lower, ti.upper) >= 0 {
+		return nil
+	}
+	if ti.lower.kind == Unbounded || ti.upper.kind == Unbounded {
+		return []*TimeInterval{NewTimeIntervalFromBounds(ti.lower, ti.upper)}
+	}
+	l, r := ti.Left(), ti.Right()
+	// the duration in nanoseconds is computed with big integers, r.Sub(l) saturates beyond about 292 years
+	w := big.NewInt(r.Unix() - l.Unix())
+	w.Mul(w, big.NewInt(int64(time.Second)))
+	w.Add(w, big.NewInt(int64(r.Nanosecond()-l.Nanosecond())))
+	var pieces []*TimeInterval
+	lower, left := ti.lower, l
+	q, sec, nsec := new(big.Int), new(big.Int), new(big.Int)
+	for k := int64(1); k < int64(n); k++ {
+		q.Mul(w, big.NewInt(k))
+		q.Quo(q, big.NewInt(int64(n)))
+		sec.QuoRem(q, big.NewInt(int64(time.Second)), nsec)
+		b := time.Unix(l.Unix()+sec.Int64(), int64(l.Nanosecond())+nsec.Int64()).In(l.Location())
+		if !b.After(left) {
+			continue
+		}
+		pieces = append(pieces, NewTimeIntervalFromBounds(lower, ExcludedBound(b)))
+		lower, left = IncludedBound(b), b
+	}
+	return append(pieces, NewTimeIntervalFromBounds(lower, ti.upper))
+}
+
+// PartitionWidth splits this interval into pieces of duration d starting from its left end,
+// the last piece may be shorter and an unbounded interval is not split
+func (ti *TimeInterval) PartitionWidth(d time.Duration) []*TimeInterval {
+	if d <= 0 || compareLowerUpper(compareTime, ti.lower, ti.upper) >= 0 {
+		return nil
+	}
+	if ti.lower.kind == Unbounded || ti.upper.kind == Unbounded {
+		return []*TimeInterval{NewTimeIntervalFromBounds(ti.lower, ti.upper)}
+	}
+	l, r := ti.Left(), ti.Right()
+	var pieces []*TimeInterval
+	lower, left := ti.lower, l
+	for b := l.Add(d); b.After(left) && b.Before(r); b = b.Add(d) {
+		pieces = append(pieces, NewTimeIntervalFromBounds(lower, ExcludedBound(b)))
+		lower, left = IncludedBound(b), b
+	}
+	return append(pieces, NewTimeIntervalFromBounds(lower, ti.upper))
+}
+
+// Partition splits this range into n ranges by interpolating keys as big-endian fractions,
+// the split keys are padded so the pieces are of nearly equal size
+func (kr *KeyRange) Partition(n int) []*KeyRange {
+	if n <= 0 || kr.IsEmpty() {
+		return nil
+	}
+	// enough extra bytes to tell n split keys apart between any two different keys
+	size := max(len(kr.start), len(kr.end)) + bits.Len(uint(n))/8 + 1
+	start := new(big.Int).SetBytes(padKey(kr.start, size))
+	end := new(big.Int).Lsh(big.NewInt(1), uint(size*8))
+	if !kr.Unbounded() {
+		end.SetBytes(padKey(kr.end, size))
+	}
+	w := new(big.Int).Sub(end, start)
+	keys := make([][]byte, 0, n-1)
+	for k := 1; k < n; k++ {
+		b := new(big.Int).Mul(w, big.NewInt(int64(k)))
+		b.Quo(b, big.NewInt(int64(n))).Add(b, start)
+		keys = append(keys, b.FillBytes(make([]byte, size)))
+	}
+	return kr.Split(keys...)
+}
+
+// padKey returns the key with zeros appended to size bytes
+func padKey(key []byte, size int) []byte {
+	padded := make([]byte, size)
+	copy(padded, key)
+	return padded
+}
@@ -0,0 +1,137 @@
+package interval
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPartition(t *testing.T) {
+	tests := []struct {
+		name string
+		bi   *BaseInterval[int64]
+		n    int
+		want []*BaseInterval[int64]
+	}{
+		{
+			name: "even",
+			bi:   NewBaseInterval[int64](0, 100),
+			n:    4,
+			want: []*BaseInterval[int64]{
+				NewBaseInterval[int64](0, 25), NewBaseInterval[int64](25, 50),
+				NewBaseInterval[int64](50, 75), NewBaseInterval[int64](75, 100),
+			},
+		},
+		{
+			name: "edges",
+			bi:   NewBaseInterval[int64](0, 10, OpenClosed),
+			n:    3,
+			want: []*BaseInterval[int64]{
+				NewBaseInterval[int64](0, 4, Open), NewBaseInterval[int64](4, 7), NewBaseInterval[int64](7, 10, Closed),
+			},
+		},
+		{
+			name: "fewMembers",
+			bi:   NewBaseInterval[int64](0, 2, Closed),
+			n:    5,
+			want: []*BaseInterval[int64]{
+				NewBaseInterval[int64](0, 1), NewBaseInterval[int64](1, 2), NewBaseInterval[int64](2, 2, Closed),
+			},
+		},
+		{name: "empty", bi: NewBaseInterval[int64](0, 1, Open), n: 2, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Partition(tt.bi, tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Partition() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// the whole hash space [0, 2^64)
+	got := Partition(NewBaseInterval[uint64](0, math.MaxUint64, Closed), 4)
+	want := []*BaseInterval[uint64]{
+		NewBaseInterval[uint64](0, 1<<62), NewBaseInterval[uint64](1<<62, 2<<62),
+		NewBaseInterval[uint64](2<<62, 3<<62), NewBaseInterval[uint64](3<<62, math.MaxUint64, Closed),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Partition() = %v, want %v", got, want)
+	}
+}
+
+func TestPartitionWidth(t *testing.T) {
+	got := PartitionWidth(NewBaseInterval[int8](100, 127, Closed), 10)
+	want := []*BaseInterval[int8]{
+		NewBaseInterval[int8](100, 110), NewBaseInterval[int8](110, 120), NewBaseInterval[int8](120, 127, Closed),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PartitionWidth() = %v, want %v", got, want)
+	}
+}
+
+func TestPartitionFloat(t *testing.T) {
+	got := PartitionFloat(NewBaseInterval[float64](0, 1, Open), 4)
+	want := []*BaseInterval[float64]{
+		NewBaseInterval[float64](0, 0.25, Open), NewBaseInterval[float64](0.25, 0.5),
+		NewBaseInterval[float64](0.5, 0.75), NewBaseInterval[float64](0.75, 1),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PartitionFloat() = %v, want %v", got, want)
+	}
+	got = PartitionFloatWidth(NewBaseInterval[float64](0, 1, Closed), 0.4)
+	want = []*BaseInterval[float64]{
+		NewBaseInterval[float64](0, 0.4), NewBaseInterval[float64](0.4, 0.8), NewBaseInterval[float64](0.8, 1, Closed),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PartitionFloatWidth() = %v, want %v", got, want)
+	}
+	unbounded := NewBaseInterval(0, math.Inf(1), ClosedOpen)
+	if got := PartitionFloatWidth(unbounded, 1); !reflect.DeepEqual(got, []*BaseInterval[float64]{unbounded}) {
+		t.Errorf("PartitionFloatWidth() = %v, want %v", got, unbounded)
+	}
+}
+
+func TestTimeInterval_Partition(t *testing.T) {
+	d1 := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ti := NewTimeInterval(d1, d1.Add(72*time.Hour), Closed)
+	got := ti.Partition(3)
+	want := []*TimeInterval{
+		NewTimeInterval(d1, d1.Add(24*time.Hour)),
+		NewTimeInterval(d1.Add(24*time.Hour), d1.Add(48*time.Hour)),
+		NewTimeInterval(d1.Add(48*time.Hour), d1.Add(72*time.Hour), Closed),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Partition() = %v, want %v", got, want)
+	}
+	if got := ti.PartitionWidth(24 * time.Hour); !reflect.DeepEqual(got, want) {
+		t.Errorf("PartitionWidth() = %v, want %v", got, want)
+	}
+
+	// a millennium is wider than the largest Duration
+	y := func(year int) time.Time { return time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC) }
+	want = []*TimeInterval{
+		NewTimeInterval(y(1000), y(1250).Add(-12*time.Hour)),
+		NewTimeInterval(y(1250).Add(-12*time.Hour), y(1500)),
+		NewTimeInterval(y(1500), y(1750).Add(-12*time.Hour)),
+		NewTimeInterval(y(1750).Add(-12*time.Hour), y(2000)),
+	}
+	if got := NewTimeInterval(y(1000), y(2000)).Partition(4); !reflect.DeepEqual(got, want) {
+		t.Errorf("Partition() = %v, want %v", got, want)
+	}
+}
+
+func TestKeyRange_Partition(t *testing.T) {
+	got := NewKeyRange([]byte{0x00}, []byte{0x80}).Partition(2)
+	want := []*KeyRange{
+		NewKeyRange([]byte{0x00}, []byte{0x40, 0x00}),
+		NewKeyRange([]byte{0x40, 0x00}, []byte{0x80}),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Partition() = %v, want %v", got, want)
+	}
+	pieces := NewKeyRange([]byte("a"), nil).Partition(1000)
+	if len(pieces) != 1000 || !reflect.DeepEqual(MergeKeyRanges(pieces), []*KeyRange{NewKeyRange([]byte("a"), nil)}) {
+		t.Errorf("Partition() gives %d pieces which do not tile the range", len(pieces))
+	}
+}
@@ -0,0 +1,48 @@
+package interval
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseRangeList parses shorthand ranges like "8000-8080,9000" to a set of closed intervals,
+// each element is a single value or two values joined by a dash, negative values are allowed like "-5--1"
+func ParseRangeList(str string) (*BaseIntervalSet[int64], error) {
+	elements := strings.Split(str, Spacer)
+	intervals := make([]*BaseInterval[int64], 0, len(elements))
+	for _, element := range elements {
+		element = strings.Trim(element, Space)
+		lv, rv := element, element
+		// the dash after the first character separates the values, a leading one is a sign
+		if i := strings.Index(element[min(1, len(element)):], RangeDash); i >= 0 {
+			lv, rv = strings.Trim(element[:i+1], Space), strings.Trim(element[i+2:], Space)
+		}
+		l, err := strconv.ParseInt(lv, 10, 64)
+		if err != nil {
+			return nil, RangeListErr
+		}
+		r, err := strconv.ParseInt(rv, 10, 64)
+		if err != nil || l > r {
+			return nil, RangeListErr
+		}
+		intervals = append(intervals, NewBaseInterval[int64](l, r, Closed))
+	}
+	return NewIntegerSet[int64](intervals...), nil
+}
+
+// FormatRangeList formats the set to the shorthand form of ParseRangeList, runs of consecutive
+// integers are collapsed like "8000-8081,9000"
+func FormatRangeList[T integer](set *BaseIntervalSet[T]) string {
+	// normalizing as integer set merges adjacent intervals of a set built by NewBaseIntervalSet
+	intervals := NewIntegerSet[T](set.Intervals()...).Intervals()
+	strs := make([]string, 0, len(intervals))
+	for _, bi := range intervals {
+		if l, r := bi.ends(); l == r {
+			strs = append(strs, fmt.Sprint(l))
+		} else {
+			strs = append(strs, fmt.Sprint(l)+RangeDash+fmt.Sprint(r))
+		}
+	}
+	return strings.Join(strs, Spacer)
+}
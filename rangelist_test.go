@@ -0,0 +1,64 @@
+package interval
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseRangeList(t *testing.T) {
+	tests := []struct {
+		name    string
+		str     string
+		want    []*BaseInterval[int64]
+		wantErr bool
+	}{
+		{
+			name: "ports",
+			str:  "8000-8080,9000",
+			want: []*BaseInterval[int64]{NewBaseInterval[int64](8000, 8080, Closed), NewBaseInterval[int64](9000, 9000, Closed)},
+		},
+		{
+			name: "mergeAndSort",
+			str:  "9000, 22, 8081-8090 ,8000-8080, 23",
+			want: []*BaseInterval[int64]{
+				NewBaseInterval[int64](22, 23, Closed),
+				NewBaseInterval[int64](8000, 8090, Closed),
+				NewBaseInterval[int64](9000, 9000, Closed),
+			},
+		},
+		{
+			name: "negative",
+			str:  "-5--1,-10",
+			want: []*BaseInterval[int64]{NewBaseInterval[int64](-10, -10, Closed), NewBaseInterval[int64](-5, -1, Closed)},
+		},
+		{name: "reversed", str: "80-22", wantErr: true},
+		{name: "empty", str: "80,,90", wantErr: true},
+		{name: "value", str: "80-x", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRangeList(tt.str)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseRangeList() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got.Intervals(), tt.want) {
+				t.Errorf("ParseRangeList() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatRangeList(t *testing.T) {
+	set, err := ParseRangeList("9000,8000-8080,8081")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := FormatRangeList(set), "8000-8081,9000"; got != want {
+		t.Errorf("FormatRangeList() = %v, want %v", got, want)
+	}
+	continuous := NewBaseIntervalSet[uint16](NewBaseInterval[uint16](1, 3, Closed), NewBaseInterval[uint16](3, 6), NewBaseInterval[uint16](6, 8, Open))
+	if got, want := FormatRangeList(continuous), "1-5,7"; got != want {
+		t.Errorf("FormatRangeList() = %v, want %v", got, want)
+	}
+}
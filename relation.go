@@ -0,0 +1,279 @@
+package interval
+
+import (
+	"bytes"
+	"net/netip"
+)
+
+// The relations between two intervals compare their bounds only, like the Range of Guava:
+// an empty interval like [2,2) still has a position, and discrete values are not canonicalized,
+// so the integer intervals [1,2] and [3,4] are not connected
+
+// encloses returns true if the bounds of o are within b
+func (b *bounds[T]) encloses(cmp func(a, b T) int, o *bounds[T]) bool {
+	return compareLower(cmp, b.lower, o.lower) <= 0 && compareUpper(cmp, b.upper, o.upper) >= 0
+}
+
+// isConnected returns true if no value lies strictly between b and o,
+// so "[1,2)" and "[2,3]" are connected but "[1,2)" and "(2,3]" are not
+func (b *bounds[T]) isConnected(cmp func(a, b T) int, o *bounds[T]) bool {
+	return compareLowerUpper(cmp, b.lower, o.upper) <= 0 && compareLowerUpper(cmp, o.lower, b.upper) <= 0
+}
+
+// span returns the smallest bounds enclosing both b and o
+func (b *bounds[T]) span(cmp func(a, b T) int, o *bounds[T]) bounds[T] {
+	s := *b
+	if compareLower(cmp, o.lower, s.lower) < 0 {
+		s.lower = o.lower
+	}
+	if compareUpper(cmp, o.upper, s.upper) > 0 {
+		s.upper = o.upper
+	}
+	return s
+}
+
+// gap returns the bounds between b and o, ok is false if they overlap.
+// The gap of two connected intervals like "[1,2)" and "[2,3]" is the empty "[2,2)"
+func (b *bounds[T]) gap(cmp func(a, b T) int, o *bounds[T]) (g bounds[T], ok bool) {
+	first, second := b, o
+	if compareLower(cmp, second.lower, first.lower) < 0 {
+		first, second = second, first
+	}
+	upper, lower := first.upper.upperCut(), second.lower.lowerCut()
+	if compareCut(cmp, upper, lower) > 0 {
+		return g, false
+	}
+	// the upper cut of the first interval starts the gap and the lower cut of the second one ends it
+	return bounds[T]{lower: lowerBound(upper), upper: upperBound(lower)}, true
+}
+
+// Encloses returns true if other is within this interval
+func (bi *BaseInterval[T]) Encloses(other *BaseInterval[T]) bool {
+	return bi.encloses(compareBase[T], &other.bounds)
+}
+
+// IsConnected returns true if no value lies strictly between this interval and other,
+// such as "[1,2)" and "[2,3]", the union of connected intervals is an interval
+func (bi *BaseInterval[T]) IsConnected(other *BaseInterval[T]) bool {
+	return bi.isConnected(compareBase[T], &other.bounds)
+}
+
+// Span returns the smallest interval enclosing both this interval and other
+func (bi *BaseInterval[T]) Span(other *BaseInterval[T]) *BaseInterval[T] {
+	return &BaseInterval[T]{bounds: bi.span(compareBase[T], &other.bounds)}
+}
+
+// Gap returns the interval between this interval and other, ok is false if they overlap
+func (bi *BaseInterval[T]) Gap(other *BaseInterval[T]) (gap *BaseInterval[T], ok bool) {
+	g, ok := bi.gap(compareBase[T], &other.bounds)
+	if !ok {
+		return nil, false
+	}
+	return &BaseInterval[T]{bounds: g}, true
+}
+
+// Encloses returns true if other is within this interval
+func (i *Interval[T]) Encloses(other *Interval[T]) bool {
+	return i.encloses(Compare[T], &other.bounds)
+}
+
+// IsConnected returns true if no value lies strictly between this interval and other
+func (i *Interval[T]) IsConnected(other *Interval[T]) bool {
+	return i.isConnected(Compare[T], &other.bounds)
+}
+
+// Span returns the smallest interval enclosing both this interval and other
+func (i *Interval[T]) Span(other *Interval[T]) *Interval[T] {
+	return &Interval[T]{bounds: i.span(Compare[T], &other.bounds)}
+}
+
+// Gap returns the interval between this interval and other, ok is false if they overlap
+func (i *Interval[T]) Gap(other *Interval[T]) (gap *Interval[T], ok bool) {
+	g, ok := i.gap(Compare[T], &other.bounds)
+	if !ok {
+		return nil, false
+	}
+	return &Interval[T]{bounds: g}, true
+}
+
+// Encloses returns true if other is within this interval
+func (ti *TimeInterval) Encloses(other *TimeInterval) bool {
+	return ti.encloses(compareTime, &other.bounds)
+}
+
+// IsConnected returns true if no time lies strictly between this interval and other
+func (ti *TimeInterval) IsConnected(other *TimeInterval) bool {
+	return ti.isConnected(compareTime, &other.bounds)
+}
+
+// Span returns the smallest interval enclosing both this interval and other
+func (ti *TimeInterval) Span(other *TimeInterval) *TimeInterval {
+	return &TimeInterval{bounds: ti.span(compareTime, &other.bounds)}
+}
+
+// Gap returns the interval between this interval and other, ok is false if they overlap
+func (ti *TimeInterval) Gap(other *TimeInterval) (gap *TimeInterval, ok bool) {
+	g, ok := ti.gap(compareTime, &other.bounds)
+	if !ok {
+		return nil, false
+	}
+	return &TimeInterval{bounds: g}, true
+}
+
+// Encloses returns true if other is within this interval, a nil end encloses any time
+func (ti *NullableTimeInterval) Encloses(other *NullableTimeInterval) bool {
+	return ti.encloses(compareTime, &other.bounds)
+}
+
+// IsConnected returns true if no time lies strictly between this interval and other
+func (ti *NullableTimeInterval) IsConnected(other *NullableTimeInterval) bool {
+	return ti.isConnected(compareTime, &other.bounds)
+}
+
+// Span returns the smallest interval enclosing both this interval and other
+func (ti *NullableTimeInterval) Span(other *NullableTimeInterval) *NullableTimeInterval {
+	return &NullableTimeInterval{bounds: ti.span(compareTime, &other.bounds)}
+}
+
+// Gap returns the interval between this interval and other, ok is false if they overlap
+func (ti *NullableTimeInterval) Gap(other *NullableTimeInterval) (gap *NullableTimeInterval, ok bool) {
+	g, ok := ti.gap(compareTime, &other.bounds)
+	if !ok {
+		return nil, false
+	}
+	return &NullableTimeInterval{bounds: g}, true
+}
+
+// Encloses returns true if other is within this interval
+func (ii *IPInterval) Encloses(other *IPInterval) bool {
+	return ii.encloses(netip.Addr.Compare, &other.bounds)
+}
+
+// IsConnected returns true if no address lies strictly between this interval and other
+func (ii *IPInterval) IsConnected(other *IPInterval) bool {
+	return ii.isConnected(netip.Addr.Compare, &other.bounds)
+}
+
+// Span returns the smallest interval enclosing both this interval and other,
+// it is empty like NewIPInterval if their address families differ
+func (ii *IPInterval) Span(other *IPInterval) *IPInterval {
+	if ii.Left().Is4() != other.Left().Is4() {
+		return NewIPInterval(ii.Left(), other.Left())
+	}
+	return &IPInterval{bounds: ii.span(netip.Addr.Compare, &other.bounds)}
+}
+
+// Gap returns the interval between this interval and other, ok is false if they overlap
+// or their address families differ
+func (ii *IPInterval) Gap(other *IPInterval) (gap *IPInterval, ok bool) {
+	if ii.Left().Is4() != other.Left().Is4() {
+		return nil, false
+	}
+	g, ok := ii.gap(netip.Addr.Compare, &other.bounds)
+	if !ok {
+		return nil, false
+	}
+	return &IPInterval{bounds: g}, true
+}
+
+// Encloses returns true if other is within this interval, the values are ordered by the cmp of this interval
+func (ci *CmpInterval[T]) Encloses(other *CmpInterval[T]) bool {
+	return ci.encloses(ci.cmp, &other.bounds)
+}
+
+// IsConnected returns true if no value lies strictly between this interval and other
+func (ci *CmpInterval[T]) IsConnected(other *CmpInterval[T]) bool {
+	return ci.isConnected(ci.cmp, &other.bounds)
+}
+
+// Span returns the smallest interval enclosing both this interval and other
+func (ci *CmpInterval[T]) Span(other *CmpInterval[T]) *CmpInterval[T] {
+	return &CmpInterval[T]{bounds: ci.span(ci.cmp, &other.bounds), cmp: ci.cmp}
+}
+
+// Gap returns the interval between this interval and other, ok is false if they overlap
+func (ci *CmpInterval[T]) Gap(other *CmpInterval[T]) (gap *CmpInterval[T], ok bool) {
+	g, ok := ci.gap(ci.cmp, &other.bounds)
+	if !ok {
+		return nil, false
+	}
+	return &CmpInterval[T]{bounds: g, cmp: ci.cmp}, true
+}
+
+// keyBounds returns the bounds of this range, an empty end is Unbounded
+func (kr *KeyRange) keyBounds() *bounds[[]byte] {
+	b := &bounds[[]byte]{lower: IncludedBound(kr.start), upper: ExcludedBound(kr.end)}
+	if kr.Unbounded() {
+		b.upper = UnboundedBound[[]byte]()
+	}
+	return b
+}
+
+// keyRange returns the range of bounds b, which are closed on the left and open on the right
+func keyRange(b bounds[[]byte]) *KeyRange {
+	return NewKeyRange(b.lower.value, b.upper.value)
+}
+
+// Encloses returns true if other is within this range
+func (kr *KeyRange) Encloses(other *KeyRange) bool {
+	return kr.keyBounds().encloses(bytes.Compare, other.keyBounds())
+}
+
+// IsConnected returns true if no key lies strictly between this range and other, such as ["a","b") and ["b","c")
+func (kr *KeyRange) IsConnected(other *KeyRange) bool {
+	return kr.keyBounds().isConnected(bytes.Compare, other.keyBounds())
+}
+
+// Span returns the smallest range enclosing both this range and other
+func (kr *KeyRange) Span(other *KeyRange) *KeyRange {
+	return keyRange(kr.keyBounds().span(bytes.Compare, other.keyBounds()))
+}
+
+// Gap returns the range between this range and other, ok is false if they overlap
+func (kr *KeyRange) Gap(other *KeyRange) (gap *KeyRange, ok bool) {
+	g, ok := kr.keyBounds().gap(bytes.Compare, other.keyBounds())
+	if !ok {
+		return nil, false
+	}
+	return keyRange(g), true
+}
+
+// genomicStrand returns the strand shared by a and b, StrandNone if they differ
+func genomicStrand(a, b *GenomicInterval) Strand {
+	if a.Strand == b.Strand {
+		return a.Strand
+	}
+	return StrandNone
+}
+
+// Encloses returns true if other is within this feature on the same chromosome, whatever their strands
+func (gi *GenomicInterval) Encloses(other *GenomicInterval) bool {
+	return gi.Chrom == other.Chrom && gi.Interval().Encloses(other.Interval())
+}
+
+// IsConnected returns true if this feature and other are on the same chromosome and no base lies between them
+func (gi *GenomicInterval) IsConnected(other *GenomicInterval) bool {
+	return gi.Chrom == other.Chrom && gi.Interval().IsConnected(other.Interval())
+}
+
+// Span returns the smallest feature enclosing both this feature and other, it is the empty feature
+// at the start of this one if their chromosomes differ. The strand is kept if both features share it
+func (gi *GenomicInterval) Span(other *GenomicInterval) *GenomicInterval {
+	if gi.Chrom != other.Chrom {
+		return NewGenomicInterval(gi.Chrom, gi.Start, gi.Start, gi.Strand)
+	}
+	s := gi.Interval().Span(other.Interval())
+	return NewGenomicInterval(gi.Chrom, s.lower.value, s.upper.value, genomicStrand(gi, other))
+}
+
+// Gap returns the feature between this feature and other, ok is false if they overlap or their chromosomes differ
+func (gi *GenomicInterval) Gap(other *GenomicInterval) (gap *GenomicInterval, ok bool) {
+	if gi.Chrom != other.Chrom {
+		return nil, false
+	}
+	g, ok := gi.Interval().Gap(other.Interval())
+	if !ok {
+		return nil, false
+	}
+	return NewGenomicInterval(gi.Chrom, g.lower.value, g.upper.value, genomicStrand(gi, other)), true
+}
@@ -0,0 +1,128 @@
+package interval
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestBaseInterval_Relations(t *testing.T) {
+	tests := []struct {
+		a, b                string
+		encloses, connected bool
+		span, gap           string
+	}{
+		{a: "[1,2)", b: "[2,3]", connected: true, span: "[1,3]", gap: "[2,2)"},
+		{a: "[1,2)", b: "(2,3]", span: "[1,3]", gap: "[2,2]"},
+		{a: "[1,2]", b: "(2,3]", connected: true, span: "[1,3]", gap: "(2,2]"},
+		{a: "(2,3]", b: "[1,2)", span: "[1,3]", gap: "[2,2]"},
+		{a: "[0,10]", b: "(2,3)", encloses: true, connected: true, span: "[0,10]"},
+		{a: "(0,10)", b: "[0,3)", connected: true, span: "[0,10)"},
+		{a: "[1,2]", b: "[4,5)", span: "[1,5)", gap: "(2,4)"},
+		{a: "[1,2]", b: "[3,4]", span: "[1,4]", gap: "(2,3)"},
+		{a: "[60,+inf)", b: "[1,20]", span: "[1,+inf)", gap: "(20,60)"},
+		{a: "[-inf,+inf]", b: "[1,20]", encloses: true, connected: true, span: "(-inf,+inf)"},
+	}
+	for _, tt := range tests {
+		a, _ := ParseIntInterval(tt.a)
+		b, _ := ParseIntInterval(tt.b)
+		if got := a.Encloses(b); got != tt.encloses {
+			t.Errorf("%v.Encloses(%v) = %v", a, b, got)
+		}
+		if got := a.IsConnected(b); got != tt.connected || b.IsConnected(a) != got {
+			t.Errorf("%v.IsConnected(%v) = %v", a, b, got)
+		}
+		if got := a.Span(b).String(); got != tt.span {
+			t.Errorf("%v.Span(%v) = %v, want %v", a, b, got, tt.span)
+		}
+		gap, ok := a.Gap(b)
+		if ok != (tt.gap != "") || (ok && gap.String() != tt.gap) {
+			t.Errorf("%v.Gap(%v) = %v, %v, want %v", a, b, gap, ok, tt.gap)
+		}
+	}
+}
+
+func TestRelations(t *testing.T) {
+	t1 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2, t3 := t1.Add(time.Hour), t1.Add(2*time.Hour)
+	if !NewTimeInterval(t1, t2).IsConnected(NewTimeInterval(t2, t3)) || NewTimeInterval(t1, t2).Encloses(NewTimeInterval(t1, t2, Closed)) {
+		t.Errorf("time interval relations are wrong")
+	}
+	if gap, ok := NewTimeInterval(t1, t2, Open).Gap(NewTimeInterval(t3, t3.Add(time.Hour))); !ok || gap.String() != NewTimeInterval(t2, t3, ClosedOpen).String() {
+		t.Errorf("Gap() = %v, %v", gap, ok)
+	}
+
+	nti := NewNullableTimeInterval(nil, &t2)
+	if !nti.Encloses(NewNullableTimeInterval(&t1, &t2)) || nti.Encloses(NewNullableTimeInterval(nil, &t3)) {
+		t.Errorf("Encloses() of %v is wrong", nti)
+	}
+	if got := nti.Span(NewNullableTimeInterval(&t3, nil)); got.Left() != nil || got.Right() != nil {
+		t.Errorf("Span() = %v", got)
+	}
+
+	ip, _ := ParseIPInterval("10.0.0.0/24")
+	if !ip.Encloses(NewIPInterval(netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("10.0.0.9"))) {
+		t.Errorf("Encloses() of %v is wrong", ip)
+	}
+	if _, ok := ip.Gap(NewIPInterval(netip.MustParseAddr("10.0.0.255"), netip.MustParseAddr("10.0.1.0"))); ok {
+		t.Errorf("Gap() of overlapping intervals is ok")
+	}
+
+	v6 := NewPrefixInterval(netip.MustParsePrefix("::/120"))
+	if got := ip.Span(v6); len(got.Prefixes()) != 0 || got.Contains(netip.MustParseAddr("10.0.0.1")) {
+		t.Errorf("Span() of mixed families = %v", got)
+	}
+	if _, ok := ip.Gap(v6); ok {
+		t.Errorf("Gap() of mixed families is ok")
+	}
+
+	ci := NewOrderedInterval("a", "c", Closed)
+	if got := ci.Span(NewOrderedInterval("b", "d")).String(); got != "[a,d)" {
+		t.Errorf("Span() = %v", got)
+	}
+}
+
+func TestKeyRange_Relations(t *testing.T) {
+	ab, bc := NewKeyRange([]byte("a"), []byte("b")), NewKeyRange([]byte("b"), []byte("c"))
+	tail := NewKeyRange([]byte("d"), nil)
+	if !ab.IsConnected(bc) || ab.IsConnected(tail) || !PrefixRange([]byte("a")).Encloses(NewKeyRange([]byte("ab"), []byte("ac"))) {
+		t.Errorf("key range relations are wrong")
+	}
+	if got := ab.Span(tail).String(); got != `["a",NULL)` {
+		t.Errorf("Span() = %v", got)
+	}
+	if gap, ok := ab.Gap(tail); !ok || gap.String() != `["b","d")` {
+		t.Errorf("Gap() = %v, %v", gap, ok)
+	}
+	if gap, ok := ab.Gap(bc); !ok || !gap.IsEmpty() {
+		t.Errorf("Gap() = %v, %v", gap, ok)
+	}
+	if _, ok := tail.Gap(NewKeyRange([]byte("e"), []byte("f"))); ok {
+		t.Errorf("Gap() of overlapping ranges is ok")
+	}
+}
+
+func TestGenomicInterval_Relations(t *testing.T) {
+	gene := NewGenomicInterval("chr1", 100, 200, StrandForward)
+	exon := NewGenomicInterval("chr1", 120, 150, StrandForward)
+	next := NewGenomicInterval("chr1", 300, 400, StrandReverse)
+	if !gene.Encloses(exon) || exon.Encloses(gene) || gene.Encloses(NewGenomicInterval("chr2", 120, 150, StrandForward)) {
+		t.Errorf("Encloses() is wrong")
+	}
+	if !gene.IsConnected(NewGenomicInterval("chr1", 200, 250, StrandNone)) || gene.IsConnected(next) {
+		t.Errorf("IsConnected() is wrong")
+	}
+	if got := gene.Span(next).String(); got != "chr1:[100,400)" {
+		t.Errorf("Span() = %v", got)
+	}
+	if got := gene.Span(exon).String(); got != "chr1:[100,200)+" {
+		t.Errorf("Span() = %v", got)
+	}
+	if gap, ok := gene.Gap(next); !ok || gap.String() != "chr1:[200,300)" {
+		t.Errorf("Gap() = %v, %v", gap, ok)
+	}
+	other := NewGenomicInterval("chr2", 300, 400, StrandForward)
+	if _, ok := gene.Gap(other); ok || gene.Span(other).Len() != 0 {
+		t.Errorf("relations across chromosomes are wrong")
+	}
+}
@@ -0,0 +1,344 @@
+package interval
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+)
+
+const defaultRTreeMaxEntries = 16
+
+// RTreeEntry is a box indexed by an RTree with its value
+type RTreeEntry[T number, V any] struct {
+	Box   *Box[T]
+	Value V
+}
+
+// rtreeNode is a node of an RTree bounded by the closed hull [lo,hi] of its children or entries
+type rtreeNode[T number, V any] struct {
+	lo       []T
+	hi       []T
+	children []*rtreeNode[T, V]
+	entries  []RTreeEntry[T, V]
+}
+
+func (n *rtreeNode[T, V]) leaf() bool {
+	return n.children == nil
+}
+
+func (n *rtreeNode[T, V]) size() int {
+	if n.leaf() {
+		return len(n.entries)
+	}
+	return len(n.children)
+}
+
+// extend grows the hull of this node to cover [lo,hi]
+func (n *rtreeNode[T, V]) extend(lo, hi []T) {
+	if n.lo == nil {
+		n.lo, n.hi = append([]T{}, lo...), append([]T{}, hi...)
+		return
+	}
+	for i := range lo {
+		n.lo[i], n.hi[i] = min(n.lo[i], lo[i]), max(n.hi[i], hi[i])
+	}
+}
+
+// refresh recomputes the hull of this node from its children or entries
+func (n *rtreeNode[T, V]) refresh() {
+	n.lo, n.hi = nil, nil
+	for _, c := range n.children {
+		n.extend(c.lo, c.hi)
+	}
+	for _, e := range n.entries {
+		n.extend(boxHull(e.Box))
+	}
+}
+
+// boxHull returns the ends of every axis of the box
+func boxHull[T number](b *Box[T]) (lo, hi []T) {
+	lo, hi = make([]T, len(b.axes)), make([]T, len(b.axes))
+	for i, axis := range b.axes {
+		lo[i], hi[i] = axis.ends()
+	}
+	return lo, hi
+}
+
+func hullsOverlap[T number](lo1, hi1, lo2, hi2 []T) bool {
+	for i := range lo1 {
+		if lo1[i] > hi2[i] || lo2[i] > hi1[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func hullVolume[T number](lo, hi []T) float64 {
+	v := 1.0
+	for i := range lo {
+		v *= float64(hi[i]) - float64(lo[i])
+	}
+	return v
+}
+
+// hullDistance returns the squared euclidean distance from the point to the hull
+func hullDistance[T number](lo, hi, point []T) float64 {
+	d := 0.0
+	for i, p := range point {
+		switch {
+		case p < lo[i]:
+			d += math.Pow(float64(lo[i])-float64(p), 2)
+		case p > hi[i]:
+			d += math.Pow(float64(p)-float64(hi[i]), 2)
+		}
+	}
+	return d
+}
+
+// RTree is a spatial index of boxes supporting point stabbing, overlap and nearest box queries
+type RTree[T number, V any] struct {
+	root       *rtreeNode[T, V]
+	dims       int
+	maxEntries int
+	size       int
+}
+
+// NewRTree returns an empty RTree whose nodes hold at most maxEntries children, 16 if maxEntries is less than 2
+func NewRTree[T number, V any](maxEntries int) *RTree[T, V] {
+	if maxEntries < 2 {
+		maxEntries = defaultRTreeMaxEntries
+	}
+	return &RTree[T, V]{root: &rtreeNode[T, V]{}, maxEntries: maxEntries}
+}
+
+// BulkLoadRTree returns an RTree of the entries packed with the Sort-Tile-Recursive algorithm,
+// which gives better queries than inserting them one by one
+func BulkLoadRTree[T number, V any](entries []RTreeEntry[T, V], maxEntries int) (*RTree[T, V], error) {
+	t := NewRTree[T, V](maxEntries)
+	if len(entries) == 0 {
+		return t, nil
+	}
+	t.dims, t.size = entries[0].Box.Dims(), len(entries)
+	nodes := make([]*rtreeNode[T, V], 0, len(entries))
+	for _, e := range entries {
+		if e.Box.Dims() != t.dims {
+			return nil, BoxDimensionErr
+		}
+		n := &rtreeNode[T, V]{entries: []RTreeEntry[T, V]{e}}
+		n.refresh()
+		nodes = append(nodes, n)
+	}
+	// the entries are packed into leaves first, then every level is packed until one node is left
+	leaves := true
+	for leaves || len(nodes) > 1 {
+		groups := strTile(nodes, 0, t.dims, t.maxEntries)
+		parents := make([]*rtreeNode[T, V], 0, len(groups))
+		for _, group := range groups {
+			parent := &rtreeNode[T, V]{}
+			if leaves {
+				for _, n := range group {
+					parent.entries = append(parent.entries, n.entries...)
+				}
+			} else {
+				parent.children = group
+			}
+			parent.refresh()
+			parents = append(parents, parent)
+		}
+		nodes, leaves = parents, false
+	}
+	t.root = nodes[0]
+	return t, nil
+}
+
+// strTile groups the nodes into tiles of at most m nodes, slicing them along every axis in turn
+func strTile[T number, V any](nodes []*rtreeNode[T, V], axis, dims, m int) [][]*rtreeNode[T, V] {
+	if len(nodes) <= m {
+		return [][]*rtreeNode[T, V]{nodes}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return float64(nodes[i].lo[axis])+float64(nodes[i].hi[axis]) < float64(nodes[j].lo[axis])+float64(nodes[j].hi[axis])
+	})
+	tiles := (len(nodes) + m - 1) / m
+	slabSize := m
+	if axis < dims-1 {
+		slabs := int(math.Ceil(math.Pow(float64(tiles), 1/float64(dims-axis))))
+		slabSize = m * ((tiles + slabs - 1) / slabs)
+	}
+	var groups [][]*rtreeNode[T, V]
+	for start := 0; start < len(nodes); start += slabSize {
+		slab := nodes[start:min(start+slabSize, len(nodes))]
+		if axis < dims-1 {
+			groups = append(groups, strTile(slab, axis+1, dims, m)...)
+		} else {
+			groups = append(groups, slab)
+		}
+	}
+	return groups
+}
+
+// Len returns the number of entries
+func (t *RTree[T, V]) Len() int {
+	return t.size
+}
+
+// Insert adds the box with its value, all boxes of a tree must have the same dimension
+func (t *RTree[T, V]) Insert(b *Box[T], v V) error {
+	if t.size == 0 {
+		t.dims = b.Dims()
+	} else if b.Dims() != t.dims {
+		return BoxDimensionErr
+	}
+	if sibling := t.insert(t.root, RTreeEntry[T, V]{Box: b, Value: v}); sibling != nil {
+		root := &rtreeNode[T, V]{children: []*rtreeNode[T, V]{t.root, sibling}}
+		root.refresh()
+		t.root = root
+	}
+	t.size++
+	return nil
+}
+
+// insert adds the entry below n and returns the new sibling of n if n was split
+func (t *RTree[T, V]) insert(n *rtreeNode[T, V], e RTreeEntry[T, V]) *rtreeNode[T, V] {
+	lo, hi := boxHull(e.Box)
+	n.extend(lo, hi)
+	if n.leaf() {
+		n.entries = append(n.entries, e)
+	} else {
+		// the child needing the least enlargement, then the smallest one
+		best, bestGrowth, bestVolume := 0, math.Inf(1), math.Inf(1)
+		for i, c := range n.children {
+			volume := hullVolume(c.lo, c.hi)
+			grown := &rtreeNode[T, V]{}
+			grown.extend(c.lo, c.hi)
+			grown.extend(lo, hi)
+			growth := hullVolume(grown.lo, grown.hi) - volume
+			if growth < bestGrowth || growth == bestGrowth && volume < bestVolume {
+				best, bestGrowth, bestVolume = i, growth, volume
+			}
+		}
+		if sibling := t.insert(n.children[best], e); sibling != nil {
+			n.children = append(n.children, sibling)
+		}
+	}
+	if n.size() <= t.maxEntries {
+		return nil
+	}
+	return t.split(n)
+}
+
+// split moves the upper half of n along its widest axis to a new sibling
+func (t *RTree[T, V]) split(n *rtreeNode[T, V]) *rtreeNode[T, V] {
+	axis, spread := 0, -1.0
+	for i := range n.lo {
+		if s := float64(n.hi[i]) - float64(n.lo[i]); s > spread {
+			axis, spread = i, s
+		}
+	}
+	center := func(lo, hi []T) float64 {
+		return float64(lo[axis]) + float64(hi[axis])
+	}
+	sibling := &rtreeNode[T, V]{}
+	if n.leaf() {
+		sort.Slice(n.entries, func(i, j int) bool {
+			return center(boxHull(n.entries[i].Box)) < center(boxHull(n.entries[j].Box))
+		})
+		half := len(n.entries) / 2
+		sibling.entries = append([]RTreeEntry[T, V]{}, n.entries[half:]...)
+		n.entries = n.entries[:half]
+	} else {
+		sort.Slice(n.children, func(i, j int) bool {
+			return center(n.children[i].lo, n.children[i].hi) < center(n.children[j].lo, n.children[j].hi)
+		})
+		half := len(n.children) / 2
+		sibling.children = append([]*rtreeNode[T, V]{}, n.children[half:]...)
+		n.children = n.children[:half]
+	}
+	n.refresh()
+	sibling.refresh()
+	return sibling
+}
+
+// Stab returns the entries whose box contains the point
+func (t *RTree[T, V]) Stab(point ...T) []RTreeEntry[T, V] {
+	if len(point) != t.dims {
+		return nil
+	}
+	return t.search(t.root, point, point, func(b *Box[T]) bool {
+		return b.Contains(point...)
+	}, nil)
+}
+
+// Search returns the entries whose box overlaps the given box
+func (t *RTree[T, V]) Search(b *Box[T]) []RTreeEntry[T, V] {
+	if b.Dims() != t.dims {
+		return nil
+	}
+	lo, hi := boxHull(b)
+	return t.search(t.root, lo, hi, b.Overlaps, nil)
+}
+
+func (t *RTree[T, V]) search(n *rtreeNode[T, V], lo, hi []T, match func(*Box[T]) bool, result []RTreeEntry[T, V]) []RTreeEntry[T, V] {
+	if n.lo == nil || !hullsOverlap(n.lo, n.hi, lo, hi) {
+		return result
+	}
+	for _, e := range n.entries {
+		if match(e.Box) {
+			result = append(result, e)
+		}
+	}
+	for _, c := range n.children {
+		result = t.search(c, lo, hi, match, result)
+	}
+	return result
+}
+
+// Nearest returns at most k entries in ascending order of the euclidean distance from the point to their box,
+// the distance to a box containing the point is 0 and open ends are measured as closed ones
+func (t *RTree[T, V]) Nearest(k int, point ...T) []RTreeEntry[T, V] {
+	if len(point) != t.dims || k <= 0 || t.size == 0 {
+		return nil
+	}
+	// best first search, an entry popped from the queue is nearer than everything left in it
+	queue := &rtreeQueue[T, V]{{node: t.root, distance: hullDistance(t.root.lo, t.root.hi, point)}}
+	var result []RTreeEntry[T, V]
+	for queue.Len() > 0 && len(result) < k {
+		item := heap.Pop(queue).(rtreeQueueItem[T, V])
+		if item.node == nil {
+			result = append(result, item.entry)
+			continue
+		}
+		for _, c := range item.node.children {
+			heap.Push(queue, rtreeQueueItem[T, V]{node: c, distance: hullDistance(c.lo, c.hi, point)})
+		}
+		for _, e := range item.node.entries {
+			lo, hi := boxHull(e.Box)
+			heap.Push(queue, rtreeQueueItem[T, V]{entry: e, distance: hullDistance(lo, hi, point)})
+		}
+	}
+	return result
+}
+
+// rtreeQueueItem is either a node or an entry with its distance to the queried point
+type rtreeQueueItem[T number, V any] struct {
+	node     *rtreeNode[T, V]
+	entry    RTreeEntry[T, V]
+	distance float64
+}
+
+type rtreeQueue[T number, V any] []rtreeQueueItem[T, V]
+
+func (q rtreeQueue[T, V]) Len() int           { return len(q) }
+func (q rtreeQueue[T, V]) Less(i, j int) bool { return q[i].distance < q[j].distance }
+func (q rtreeQueue[T, V]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *rtreeQueue[T, V]) Push(x any) {
+	*q = append(*q, x.(rtreeQueueItem[T, V]))
+}
+
+func (q *rtreeQueue[T, V]) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
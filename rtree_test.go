@@ -0,0 +1,142 @@
+package interval
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func randomBoxes(r *rand.Rand, n int) []RTreeEntry[float64, int] {
+	entries := make([]RTreeEntry[float64, int], n)
+	for i := range entries {
+		x, y := r.Float64()*1000, r.Float64()*1000
+		entries[i] = RTreeEntry[float64, int]{
+			Box: NewBox(
+				NewBaseInterval(x, x+r.Float64()*10, Closed),
+				NewBaseInterval(y, y+r.Float64()*10, ClosedOpen),
+			),
+			Value: i,
+		}
+	}
+	return entries
+}
+
+func entryValues(entries []RTreeEntry[float64, int]) []int {
+	values := make([]int, 0, len(entries))
+	for _, e := range entries {
+		values = append(values, e.Value)
+	}
+	sort.Ints(values)
+	return values
+}
+
+func TestRTree(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	entries := randomBoxes(r, 2000)
+	bulk, err := BulkLoadRTree(entries, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inserted := NewRTree[float64, int](4)
+	for _, e := range entries {
+		if err := inserted.Insert(e.Box, e.Value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if bulk.Len() != len(entries) || inserted.Len() != len(entries) {
+		t.Fatalf("Len() = %d, %d, want %d", bulk.Len(), inserted.Len(), len(entries))
+	}
+
+	for q := 0; q < 200; q++ {
+		x, y := r.Float64()*1000, r.Float64()*1000
+		query := NewBox(NewBaseInterval(x, x+20, Closed), NewBaseInterval(y, y+20, Open))
+		var wantStab, wantSearch []RTreeEntry[float64, int]
+		for _, e := range entries {
+			if e.Box.Contains(x, y) {
+				wantStab = append(wantStab, e)
+			}
+			if e.Box.Overlaps(query) {
+				wantSearch = append(wantSearch, e)
+			}
+		}
+		for _, tree := range []*RTree[float64, int]{bulk, inserted} {
+			if got, want := entryValues(tree.Stab(x, y)), entryValues(wantStab); !reflect.DeepEqual(got, want) {
+				t.Errorf("Stab() = %v, want %v", got, want)
+			}
+			if got, want := entryValues(tree.Search(query)), entryValues(wantSearch); !reflect.DeepEqual(got, want) {
+				t.Errorf("Search() = %v, want %v", got, want)
+			}
+		}
+
+		nearest := bulk.Nearest(3, x, y)
+		sort.Slice(entries, func(i, j int) bool {
+			lo1, hi1 := boxHull(entries[i].Box)
+			lo2, hi2 := boxHull(entries[j].Box)
+			return hullDistance(lo1, hi1, []float64{x, y}) < hullDistance(lo2, hi2, []float64{x, y})
+		})
+		for i, e := range nearest {
+			lo1, hi1 := boxHull(e.Box)
+			lo2, hi2 := boxHull(entries[i].Box)
+			if hullDistance(lo1, hi1, []float64{x, y}) != hullDistance(lo2, hi2, []float64{x, y}) {
+				t.Errorf("Nearest()[%d] = %v, want %v", i, e.Box, entries[i].Box)
+			}
+		}
+	}
+
+	if err := inserted.Insert(NewBox(NewBaseInterval[float64](0, 1)), 0); err != BoxDimensionErr {
+		t.Errorf("Insert() error = %v, want %v", err, BoxDimensionErr)
+	}
+	if got := NewRTree[float64, int](0).Nearest(1, 0, 0); got != nil {
+		t.Errorf("Nearest() on empty tree = %v", got)
+	}
+}
+
+func BenchmarkRTree_Stab(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	tree, _ := BulkLoadRTree(randomBoxes(r, 10000), 0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.Stab(r.Float64()*1000, r.Float64()*1000)
+	}
+}
+
+func BenchmarkLinearScan_Stab(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	entries := randomBoxes(r, 10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x, y := r.Float64()*1000, r.Float64()*1000
+		for _, e := range entries {
+			e.Box.Contains(x, y)
+		}
+	}
+}
+
+func BenchmarkRTree_Nearest(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	tree, _ := BulkLoadRTree(randomBoxes(r, 10000), 0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.Nearest(5, r.Float64()*1000, r.Float64()*1000)
+	}
+}
+
+func BenchmarkBulkLoadRTree(b *testing.B) {
+	entries := randomBoxes(rand.New(rand.NewSource(1)), 10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = BulkLoadRTree(entries, 0)
+	}
+}
+
+func BenchmarkRTree_Insert(b *testing.B) {
+	entries := randomBoxes(rand.New(rand.NewSource(1)), 10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree := NewRTree[float64, int](0)
+		for _, e := range entries {
+			_ = tree.Insert(e.Box, e.Value)
+		}
+	}
+}
@@ -0,0 +1,354 @@
+package interval
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// SemVer is a semantic version, it implements SortComparable with the precedence rules of semver 2.0,
+// build metadata is ignored when comparing
+type SemVer struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	Prerelease []string
+	Build      string
+}
+
+var (
+	// MinSemVer is the lowest version, 0.0.0-0
+	MinSemVer = SemVer{Prerelease: []string{"0"}}
+	// MaxSemVer is the highest version, it is the upper end of constraints without upper bound
+	MaxSemVer = SemVer{Major: math.MaxUint64, Minor: math.MaxUint64, Patch: math.MaxUint64}
+)
+
+// ParseSemVer parse str to SemVer, a leading "v" is allowed
+func ParseSemVer(str string) (v SemVer, err error) {
+	p, err := parsePartialSemVer(str)
+	if err != nil {
+		return v, err
+	}
+	if len(p.parts) != 3 {
+		return v, SemVerErr
+	}
+	return p.min(), nil
+}
+
+// CompareTo compares the precedence of this version to other
+func (v SemVer) CompareTo(other SemVer) int {
+	if c := compareBase(v.Major, other.Major); c != 0 {
+		return c
+	}
+	if c := compareBase(v.Minor, other.Minor); c != 0 {
+		return c
+	}
+	if c := compareBase(v.Patch, other.Patch); c != 0 {
+		return c
+	}
+	// a version without prerelease is greater than its prereleases
+	switch {
+	case len(v.Prerelease) == 0 && len(other.Prerelease) == 0:
+		return 0
+	case len(v.Prerelease) == 0:
+		return 1
+	case len(other.Prerelease) == 0:
+		return -1
+	}
+	for i := 0; i < len(v.Prerelease) && i < len(other.Prerelease); i++ {
+		if c := comparePrereleaseIdentifier(v.Prerelease[i], other.Prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	return compareBase(len(v.Prerelease), len(other.Prerelease))
+}
+
+// String returns the version string
+func (v SemVer) String() string {
+	bs := &strings.Builder{}
+	bs.WriteString(strconv.FormatUint(v.Major, 10))
+	bs.WriteString(".")
+	bs.WriteString(strconv.FormatUint(v.Minor, 10))
+	bs.WriteString(".")
+	bs.WriteString(strconv.FormatUint(v.Patch, 10))
+	if len(v.Prerelease) > 0 {
+		bs.WriteString("-")
+		bs.WriteString(strings.Join(v.Prerelease, "."))
+	}
+	if v.Build != "" {
+		bs.WriteString("+")
+		bs.WriteString(v.Build)
+	}
+	return bs.String()
+}
+
+// comparePrereleaseIdentifier compares numeric identifiers numerically and lower than alphanumeric ones
+func comparePrereleaseIdentifier(a, b string) int {
+	an, bn := isNumericIdentifier(a), isNumericIdentifier(b)
+	switch {
+	case an && bn:
+		// numeric identifiers have no leading zeros so a longer one is greater
+		if c := compareBase(len(a), len(b)); c != 0 {
+			return c
+		}
+		return compareBase(a, b)
+	case an:
+		return -1
+	case bn:
+		return 1
+	}
+	return compareBase(a, b)
+}
+
+func isNumericIdentifier(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func isValidIdentifier(s string) bool {
+	for _, r := range s {
+		if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r == '-') {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// partialSemVer is a version in a constraint which may miss trailing components, like "1.2" or "1.x"
+type partialSemVer struct {
+	parts      []uint64
+	prerelease []string
+	build      string
+}
+
+func parsePartialSemVer(str string) (p partialSemVer, err error) {
+	str = strings.TrimPrefix(strings.TrimPrefix(strings.Trim(str, Space), "v"), "=")
+	if i := strings.Index(str, "+"); i >= 0 {
+		str, p.build = str[:i], str[i+1:]
+		for _, id := range strings.Split(p.build, ".") {
+			if !isValidIdentifier(id) {
+				return p, SemVerErr
+			}
+		}
+	}
+	if i := strings.Index(str, "-"); i >= 0 {
+		str, p.prerelease = str[:i], strings.Split(str[i+1:], ".")
+		for _, id := range p.prerelease {
+			if !isValidIdentifier(id) || isNumericIdentifier(id) && len(id) > 1 && id[0] == '0' {
+				return p, SemVerErr
+			}
+		}
+	}
+	if str == "" {
+		return p, nil
+	}
+	components := strings.Split(str, ".")
+	if len(components) > 3 {
+		return p, SemVerErr
+	}
+	wildcard := false
+	for _, c := range components {
+		if c == "x" || c == "X" || c == "*" {
+			wildcard = true
+			continue
+		}
+		if wildcard || !isNumericIdentifier(c) || len(c) > 1 && c[0] == '0' {
+			return p, SemVerErr
+		}
+		n, err := strconv.ParseUint(c, 10, 64)
+		if err != nil {
+			return p, SemVerErr
+		}
+		p.parts = append(p.parts, n)
+	}
+	// only a complete version may have a prerelease
+	if len(p.prerelease) > 0 && len(p.parts) != 3 {
+		return p, SemVerErr
+	}
+	return p, nil
+}
+
+// min returns the lowest version matching the partial version
+func (p partialSemVer) min() SemVer {
+	v := SemVer{Prerelease: p.prerelease, Build: p.build}
+	for i, n := range p.parts {
+		switch i {
+		case 0:
+			v.Major = n
+		case 1:
+			v.Minor = n
+		case 2:
+			v.Patch = n
+		}
+	}
+	return v
+}
+
+// bump returns the lowest prerelease of the version with component idx increased, like 2.0.0-0 for 1.2.3 and 0,
+// ok is false on overflow
+func (p partialSemVer) bump(idx int) (v SemVer, ok bool) {
+	parts := make([]uint64, idx+1)
+	copy(parts, p.parts)
+	if parts[idx] == math.MaxUint64 {
+		return v, false
+	}
+	parts[idx]++
+	return partialSemVer{parts: parts, prerelease: MinSemVer.Prerelease}.min(), true
+}
+
+// below returns the upper end of the versions lower than bump(idx)
+func (p partialSemVer) below(idx int) (SemVer, OpenClosedType) {
+	if v, ok := p.bump(idx); ok {
+		return v, ClosedOpen
+	}
+	return MaxSemVer, Closed
+}
+
+// ParseSemVerConstraint parses node-semver syntax like "^1.2", "~1.2.3", ">=1.0 <2.0", "1.x",
+// "1.2.3 - 2.3.4" and "||" unions to a set of versions. The upper ends of ranges exclude the
+// prereleases of the next version, for example "^1.2.3" is [1.2.3,2.0.0-0).
+// Unlike npm, the set contains every prerelease between its ends: npm matches a prerelease like
+// 1.5.0-beta only if a comparator has a prerelease of the same major.minor.patch, which is no interval
+// and is left to the caller
+func ParseSemVerConstraint(constraint string) (*IntervalSet[SemVer], error) {
+	return parseConstraint(constraint, "=")
+}
+
+// ParseCargoConstraint parses Cargo style constraints, comparators are separated by "," and a bare
+// version means a caret constraint
+func ParseCargoConstraint(constraint string) (*IntervalSet[SemVer], error) {
+	return parseConstraint(constraint, "^")
+}
+
+var constraintOps = []string{">=", "<=", "~>", ">", "<", "=", "^", "~"}
+
+func parseConstraint(constraint, bareOp string) (*IntervalSet[SemVer], error) {
+	set := NewIntervalSet[SemVer]()
+	for _, rangeStr := range strings.Split(constraint, "||") {
+		r, err := parseConstraintRange(rangeStr, bareOp)
+		if err != nil {
+			return nil, err
+		}
+		set = set.Union(r)
+	}
+	return set, nil
+}
+
+func parseConstraintRange(rangeStr, bareOp string) (*IntervalSet[SemVer], error) {
+	all := NewIntervalSet(NewInterval(MinSemVer, MaxSemVer, Closed))
+	if l, r, found := strings.Cut(rangeStr, " - "); found {
+		lp, err := parsePartialSemVer(l)
+		if err != nil {
+			return nil, ConstraintErr
+		}
+		rp, err := parsePartialSemVer(r)
+		if err != nil {
+			return nil, ConstraintErr
+		}
+		right, t := MaxSemVer, Closed
+		switch n := len(rp.parts); {
+		case n == 3:
+			right = rp.min()
+		case n > 0:
+			right, t = rp.below(n - 1)
+		}
+		return all.Intersect(NewIntervalSet(NewInterval(lp.min(), right, t))), nil
+	}
+
+	fields := strings.Fields(strings.ReplaceAll(rangeStr, Spacer, Space))
+	set := all
+	for i := 0; i < len(fields); i++ {
+		comparator := fields[i]
+		// an operator may be separated from its version like ">= 1.2"
+		for _, op := range constraintOps {
+			if comparator == op && i+1 < len(fields) {
+				i++
+				comparator += fields[i]
+				break
+			}
+		}
+		ci, err := parseComparator(comparator, bareOp)
+		if err != nil {
+			return nil, err
+		}
+		set = set.Intersect(NewIntervalSet(ci))
+	}
+	return set, nil
+}
+
+// parseComparator parses a single comparator like ">=1.2" to an interval
+func parseComparator(comparator, bareOp string) (*Interval[SemVer], error) {
+	op := bareOp
+	for _, o := range constraintOps {
+		if strings.HasPrefix(comparator, o) {
+			op, comparator = o, comparator[len(o):]
+			break
+		}
+	}
+	p, err := parsePartialSemVer(comparator)
+	if err != nil {
+		return nil, ConstraintErr
+	}
+	n := len(p.parts)
+	empty := NewInterval(MinSemVer, MinSemVer, Open)
+	if n == 0 {
+		// "*", "x" and "" match any version except with > and <
+		if op == ">" || op == "<" {
+			return empty, nil
+		}
+		return NewInterval(MinSemVer, MaxSemVer, Closed), nil
+	}
+	switch op {
+	case "=":
+		if n == 3 {
+			return NewInterval(p.min(), p.min(), Closed), nil
+		}
+		right, t := p.below(n - 1)
+		return NewInterval(p.min(), right, t), nil
+	case ">":
+		if n == 3 {
+			return NewInterval(p.min(), MaxSemVer, OpenClosed), nil
+		}
+		left, ok := p.bump(n - 1)
+		if !ok {
+			return empty, nil
+		}
+		return NewInterval(left, MaxSemVer, Closed), nil
+	case ">=":
+		return NewInterval(p.min(), MaxSemVer, Closed), nil
+	case "<":
+		if n == 3 {
+			return NewInterval(MinSemVer, p.min(), ClosedOpen), nil
+		}
+		right := p.min()
+		right.Prerelease = MinSemVer.Prerelease
+		return NewInterval(MinSemVer, right, ClosedOpen), nil
+	case "<=":
+		if n == 3 {
+			return NewInterval(MinSemVer, p.min(), Closed), nil
+		}
+		right, t := p.below(n - 1)
+		return NewInterval(MinSemVer, right, t), nil
+	case "~", "~>":
+		idx := 1
+		if n == 1 {
+			idx = 0
+		}
+		right, t := p.below(idx)
+		return NewInterval(p.min(), right, t), nil
+	}
+	// caret allows changes which do not modify the first non zero component
+	idx := n - 1
+	for i, part := range p.parts {
+		if part != 0 {
+			idx = i
+			break
+		}
+	}
+	right, t := p.below(idx)
+	return NewInterval(p.min(), right, t), nil
+}
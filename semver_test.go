@@ -0,0 +1,128 @@
+package interval
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestParseSemVer(t *testing.T) {
+	tests := []struct {
+		name    string
+		str     string
+		want    SemVer
+		wantErr bool
+	}{
+		{name: "plain", str: "1.10.0", want: SemVer{Major: 1, Minor: 10}},
+		{name: "prefix", str: "v1.2.3", want: SemVer{Major: 1, Minor: 2, Patch: 3}},
+		{name: "prerelease", str: "1.2.3-rc.1+build.5", want: SemVer{Major: 1, Minor: 2, Patch: 3, Prerelease: []string{"rc", "1"}, Build: "build.5"}},
+		{name: "partial", str: "1.2", wantErr: true},
+		{name: "leadingZero", str: "1.02.3", wantErr: true},
+		{name: "badPrerelease", str: "1.2.3-01", wantErr: true},
+		{name: "emptyPrerelease", str: "1.2.3-", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSemVer(tt.str)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseSemVer() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseSemVer() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSemVer_CompareTo(t *testing.T) {
+	// ordered as in the semver 2.0 specification
+	strs := []string{
+		"0.0.0-0", "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+		"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.9.0", "1.10.0", "2.0.0",
+	}
+	versions := make([]SemVer, len(strs))
+	for i := range strs {
+		v, err := ParseSemVer(strs[len(strs)-1-i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		versions[i] = v
+	}
+	sort.Slice(versions, func(i, j int) bool { return Compare(versions[i], versions[j]) < 0 })
+	for i, v := range versions {
+		if v.String() != strs[i] {
+			t.Errorf("sorted[%d] = %v, want %v", i, v, strs[i])
+		}
+	}
+	if Compare(SemVer{Major: 1, Build: "a"}, SemVer{Major: 1, Build: "b"}) != 0 {
+		t.Errorf("CompareTo() should ignore build metadata")
+	}
+}
+
+func TestParseSemVerConstraint(t *testing.T) {
+	tests := []struct {
+		constraint string
+		want       string
+		wantErr    bool
+	}{
+		{constraint: "^1.2.3", want: "[1.2.3,2.0.0-0)"},
+		{constraint: "^0.2.3", want: "[0.2.3,0.3.0-0)"},
+		{constraint: "^0.0.3", want: "[0.0.3,0.0.4-0)"},
+		{constraint: "^1.2", want: "[1.2.0,2.0.0-0)"},
+		{constraint: "^0.0", want: "[0.0.0,0.1.0-0)"},
+		{constraint: "^0.x", want: "[0.0.0,1.0.0-0)"},
+		{constraint: "~1.2.3", want: "[1.2.3,1.3.0-0)"},
+		{constraint: "~1", want: "[1.0.0,2.0.0-0)"},
+		{constraint: "1.x", want: "[1.0.0,2.0.0-0)"},
+		{constraint: "1.2.3", want: "[1.2.3,1.2.3]"},
+		{constraint: ">=1.0 <2.0", want: "[1.0.0,2.0.0-0)"},
+		{constraint: ">= 1.0.0, <2.0.0", want: "[1.0.0,2.0.0)"},
+		{constraint: ">1.2", want: "[1.3.0-0,18446744073709551615.18446744073709551615.18446744073709551615]"},
+		{constraint: "<=1.2", want: "[0.0.0-0,1.3.0-0)"},
+		{constraint: "1.2 - 2.3.4", want: "[1.2.0,2.3.4]"},
+		{constraint: "1.2.3 - 2", want: "[1.2.3,3.0.0-0)"},
+		{constraint: "^1.2.3-beta.2", want: "[1.2.3-beta.2,2.0.0-0)"},
+		{constraint: "~1.2 || ^3.0.0 || 1.2.5", want: "[1.2.0,1.3.0-0) ∪ [3.0.0,4.0.0-0)"},
+		{constraint: ">2.0.0 <1.0.0", want: "∅"},
+		{constraint: "^1.2.x.4", wantErr: true},
+		{constraint: "~1.2-beta", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.constraint, func(t *testing.T) {
+			got, err := ParseSemVerConstraint(tt.constraint)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseSemVerConstraint() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("ParseSemVerConstraint() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// prereleases between the ends are members, npm would exclude them
+	set, _ := ParseSemVerConstraint("^1.2.3")
+	if v, _ := ParseSemVer("1.5.0-beta"); !set.Contains(v) {
+		t.Errorf("%v does not contain %v", set, v)
+	}
+}
+
+func TestParseCargoConstraint(t *testing.T) {
+	set, err := ParseCargoConstraint("1.2.3, <1.8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := set.String(), "[1.2.3,1.8.0-0)"; got != want {
+		t.Errorf("ParseCargoConstraint() got = %v, want %v", got, want)
+	}
+	for str, want := range map[string]bool{"1.2.3": true, "1.10.0": false, "1.7.9": true, "1.8.0-rc.1": false, "1.2.3-rc.1": false} {
+		v, err := ParseSemVer(str)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := set.Contains(v); got != want {
+			t.Errorf("Contains(%s) = %v, want %v", str, got, want)
+		}
+	}
+}
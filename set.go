@@ -0,0 +1,350 @@
+package interval
+
+import (
+	"sort"
+	"strings"
+)
+
+// span is a non empty range between a lower and an upper cut
+type span[P any] struct {
+	lower cut[P]
+	upper cut[P]
+}
+
+// rangeSet is a normalized set of disjoint and unconnected spans in ascending order,
+// it is the implementation shared by the set types of this package
+type rangeSet[P any] struct {
+	cmp func(a, b P) int
+	// discrete is optional, spans of a discrete set are kept closed so adjacent members are merged
+	discrete *discreteDomain[P]
+	spans    []span[P]
+}
+
+// newSpan returns the span between the two cuts, ok is false if it is empty
+func (s *rangeSet[P]) newSpan(lower, upper cut[P]) (sp span[P], ok bool) {
+	if s.discrete != nil {
+		// the finite ends of a discrete span are closed
+		if lower.inf == 0 && lower.above {
+			next, ok := s.discrete.next(lower.value)
+			if !ok {
+				return sp, false
+			}
+			lower = lowerCut(next, true)
+		}
+		if upper.inf == 0 && !upper.above {
+			prev, ok := s.discrete.prev(upper.value)
+			if !ok {
+				return sp, false
+			}
+			upper = upperCut(prev, true)
+		}
+	}
+	return span[P]{lower: lower, upper: upper}, compareCut(s.cmp, lower, upper) < 0
+}
+
+// with returns a new set of the same domain holding the given normalized spans
+func (s *rangeSet[P]) with(spans []span[P]) *rangeSet[P] {
+	return &rangeSet[P]{cmp: s.cmp, discrete: s.discrete, spans: spans}
+}
+
+// connected returns true if a span ending with upper and a span starting with lower overlap or touch
+func (s *rangeSet[P]) connected(upper, lower cut[P]) bool {
+	if compareCut(s.cmp, upper, lower) >= 0 {
+		return true
+	}
+	if s.discrete == nil {
+		return false
+	}
+	// in a discrete domain [1,2] and [3,4] touch
+	if upper.inf != 0 || lower.inf != 0 {
+		return false
+	}
+	next, ok := s.discrete.next(upper.value)
+	return ok && upper.above && !lower.above && s.cmp(next, lower.value) == 0
+}
+
+// add returns a new set with the span between the two cuts added
+func (s *rangeSet[P]) add(lower, upper cut[P]) *rangeSet[P] {
+	sp, ok := s.newSpan(lower, upper)
+	if !ok {
+		return s.with(s.spans)
+	}
+	return s.with(s.normalize(append(append([]span[P]{}, s.spans...), sp)))
+}
+
+// normalize sorts the spans and merges the connected ones
+func (s *rangeSet[P]) normalize(spans []span[P]) []span[P] {
+	sort.Slice(spans, func(i, j int) bool {
+		return compareCut(s.cmp, spans[i].lower, spans[j].lower) < 0
+	})
+	var result []span[P]
+	for _, sp := range spans {
+		if n := len(result); n > 0 && s.connected(result[n-1].upper, sp.lower) {
+			if compareCut(s.cmp, sp.upper, result[n-1].upper) > 0 {
+				result[n-1].upper = sp.upper
+			}
+			continue
+		}
+		result = append(result, sp)
+	}
+	return result
+}
+
+func (s *rangeSet[P]) union(o *rangeSet[P]) *rangeSet[P] {
+	return s.with(s.normalize(append(append([]span[P]{}, s.spans...), o.spans...)))
+}
+
+func (s *rangeSet[P]) intersect(o *rangeSet[P]) *rangeSet[P] {
+	var result []span[P]
+	for i, j := 0, 0; i < len(s.spans) && j < len(o.spans); {
+		a, b := s.spans[i], o.spans[j]
+		lower, upper := a.lower, a.upper
+		if compareCut(s.cmp, b.lower, lower) > 0 {
+			lower = b.lower
+		}
+		if compareCut(s.cmp, b.upper, upper) < 0 {
+			upper = b.upper
+		}
+		if sp, ok := s.newSpan(lower, upper); ok {
+			result = append(result, sp)
+		}
+		if compareCut(s.cmp, a.upper, b.upper) < 0 {
+			i++
+		} else {
+			j++
+		}
+	}
+	return s.with(result)
+}
+
+func (s *rangeSet[P]) difference(o *rangeSet[P]) *rangeSet[P] {
+	var result []span[P]
+	j := 0
+	for _, a := range s.spans {
+		for j < len(o.spans) && compareCut(s.cmp, o.spans[j].upper, a.lower) <= 0 {
+			j++
+		}
+		lower := a.lower
+		// a span of o starting at cut c ends the remaining piece at the very same cut
+		for k := j; k < len(o.spans) && compareCut(s.cmp, o.spans[k].lower, a.upper) < 0; k++ {
+			if sp, ok := s.newSpan(lower, o.spans[k].lower); ok {
+				result = append(result, sp)
+			}
+			if compareCut(s.cmp, o.spans[k].upper, lower) > 0 {
+				lower = o.spans[k].upper
+			}
+		}
+		if sp, ok := s.newSpan(lower, a.upper); ok {
+			result = append(result, sp)
+		}
+	}
+	return s.with(result)
+}
+
+// complement returns the set of the values not in s
+func (s *rangeSet[P]) complement() *rangeSet[P] {
+	return s.with(nil).add(cut[P]{above: true, inf: -1}, cut[P]{inf: 1}).difference(s)
+}
+
+func (s *rangeSet[P]) contains(p P) bool {
+	below, above := cut[P]{value: p}, cut[P]{value: p, above: true}
+	i := sort.Search(len(s.spans), func(i int) bool {
+		return compareCut(s.cmp, s.spans[i].upper, above) >= 0
+	})
+	return i < len(s.spans) && compareCut(s.cmp, s.spans[i].lower, below) <= 0
+}
+
+// IntervalSet is a set of values built from Interval, overlapping and touching intervals are merged
+type IntervalSet[T SortComparable[T]] struct {
+	s *rangeSet[T]
+}
+
+// NewIntervalSet returns the union of the given intervals
+func NewIntervalSet[T SortComparable[T]](intervals ...*Interval[T]) *IntervalSet[T] {
+	s := &rangeSet[T]{cmp: Compare[T]}
+	for _, i := range intervals {
+		s = s.add(i.cuts())
+	}
+	return &IntervalSet[T]{s: s}
+}
+
+// Union returns a new set of the values in this set or other
+func (set *IntervalSet[T]) Union(other *IntervalSet[T]) *IntervalSet[T] {
+	return &IntervalSet[T]{s: set.s.union(other.s)}
+}
+
+// Intersect returns a new set of the values in both this set and other
+func (set *IntervalSet[T]) Intersect(other *IntervalSet[T]) *IntervalSet[T] {
+	return &IntervalSet[T]{s: set.s.intersect(other.s)}
+}
+
+// Difference returns a new set of the values in this set but not in other
+func (set *IntervalSet[T]) Difference(other *IntervalSet[T]) *IntervalSet[T] {
+	return &IntervalSet[T]{s: set.s.difference(other.s)}
+}
+
+// Contains returns true if the given element is in this set
+func (set *IntervalSet[T]) Contains(e T) bool {
+	return set.s.contains(e)
+}
+
+// IsEmpty returns true if this set has no element
+func (set *IntervalSet[T]) IsEmpty() bool {
+	return len(set.s.spans) == 0
+}
+
+// Intervals returns the disjoint intervals of this set in ascending order
+func (set *IntervalSet[T]) Intervals() []*Interval[T] {
+	intervals := make([]*Interval[T], 0, len(set.s.spans))
+	for _, sp := range set.s.spans {
+		intervals = append(intervals, newIntervalCuts(sp.lower, sp.upper))
+	}
+	return intervals
+}
+
+// String returns a readable string of this set like "[1,2) ∪ [3,4]"
+func (set *IntervalSet[T]) String() string {
+	if set.IsEmpty() {
+		return EmptySet
+	}
+	strs := make([]string, 0, len(set.s.spans))
+	for _, i := range set.Intervals() {
+		strs = append(strs, i.String())
+	}
+	return strings.Join(strs, UnionSpacer)
+}
+
+// BaseIntervalSet is a set of values built from BaseInterval, overlapping and touching intervals are merged
+type BaseIntervalSet[T baseSortable] struct {
+	s *rangeSet[T]
+}
+
+// NewBaseIntervalSet returns the union of the given intervals
+func NewBaseIntervalSet[T baseSortable](intervals ...*BaseInterval[T]) *BaseIntervalSet[T] {
+	return newBaseIntervalSet(&rangeSet[T]{cmp: compareBase[T]}, intervals)
+}
+
+// NewIntegerSet returns the union of the given integer intervals, the intervals of the set are closed
+// and adjacent integers are merged, so [1,2] and [3,4] become [1,4]
+func NewIntegerSet[T integer](intervals ...*BaseInterval[T]) *BaseIntervalSet[T] {
+	d := integerDomain[T]()
+	return newBaseIntervalSet(&rangeSet[T]{cmp: compareBase[T], discrete: &d}, intervals)
+}
+
+func newBaseIntervalSet[T baseSortable](s *rangeSet[T], intervals []*BaseInterval[T]) *BaseIntervalSet[T] {
+	for _, bi := range intervals {
+		s = s.add(bi.cuts())
+	}
+	return &BaseIntervalSet[T]{s: s}
+}
+
+// Union returns a new set of the values in this set or other
+func (set *BaseIntervalSet[T]) Union(other *BaseIntervalSet[T]) *BaseIntervalSet[T] {
+	return &BaseIntervalSet[T]{s: set.s.union(other.s)}
+}
+
+// Intersect returns a new set of the values in both this set and other
+func (set *BaseIntervalSet[T]) Intersect(other *BaseIntervalSet[T]) *BaseIntervalSet[T] {
+	return &BaseIntervalSet[T]{s: set.s.intersect(other.s)}
+}
+
+// Difference returns a new set of the values in this set but not in other
+func (set *BaseIntervalSet[T]) Difference(other *BaseIntervalSet[T]) *BaseIntervalSet[T] {
+	return &BaseIntervalSet[T]{s: set.s.difference(other.s)}
+}
+
+// Complement returns a new set of the values not in this set
+func (set *BaseIntervalSet[T]) Complement() *BaseIntervalSet[T] {
+	return &BaseIntervalSet[T]{s: set.s.complement()}
+}
+
+// Contains returns true if the given element is in this set
+func (set *BaseIntervalSet[T]) Contains(e T) bool {
+	return set.s.contains(e)
+}
+
+// IsEmpty returns true if this set has no element
+func (set *BaseIntervalSet[T]) IsEmpty() bool {
+	return len(set.s.spans) == 0
+}
+
+// Intervals returns the disjoint intervals of this set in ascending order
+func (set *BaseIntervalSet[T]) Intervals() []*BaseInterval[T] {
+	intervals := make([]*BaseInterval[T], 0, len(set.s.spans))
+	for _, sp := range set.s.spans {
+		intervals = append(intervals, newBaseIntervalCuts(sp.lower, sp.upper))
+	}
+	return intervals
+}
+
+// String returns a readable string of this set like "[1,2) ∪ [3,4]"
+func (set *BaseIntervalSet[T]) String() string {
+	if set.IsEmpty() {
+		return EmptySet
+	}
+	strs := make([]string, 0, len(set.s.spans))
+	for _, bi := range set.Intervals() {
+		strs = append(strs, bi.String())
+	}
+	return strings.Join(strs, UnionSpacer)
+}
+
+// CmpIntervalSet is a set of values built from CmpInterval, overlapping and touching intervals are merged
+type CmpIntervalSet[T any] struct {
+	s *rangeSet[T]
+}
+
+// NewCmpIntervalSet returns the union of the given intervals ordered by cmp
+func NewCmpIntervalSet[T any](cmp func(a, b T) int, intervals ...*CmpInterval[T]) *CmpIntervalSet[T] {
+	s := &rangeSet[T]{cmp: cmp}
+	for _, ci := range intervals {
+		s = s.add(ci.cuts())
+	}
+	return &CmpIntervalSet[T]{s: s}
+}
+
+// Union returns a new set of the values in this set or other
+func (set *CmpIntervalSet[T]) Union(other *CmpIntervalSet[T]) *CmpIntervalSet[T] {
+	return &CmpIntervalSet[T]{s: set.s.union(other.s)}
+}
+
+// Intersect returns a new set of the values in both this set and other
+func (set *CmpIntervalSet[T]) Intersect(other *CmpIntervalSet[T]) *CmpIntervalSet[T] {
+	return &CmpIntervalSet[T]{s: set.s.intersect(other.s)}
+}
+
+// Difference returns a new set of the values in this set but not in other
+func (set *CmpIntervalSet[T]) Difference(other *CmpIntervalSet[T]) *CmpIntervalSet[T] {
+	return &CmpIntervalSet[T]{s: set.s.difference(other.s)}
+}
+
+// Contains returns true if the given element is in this set
+func (set *CmpIntervalSet[T]) Contains(e T) bool {
+	return set.s.contains(e)
+}
+
+// IsEmpty returns true if this set has no element
+func (set *CmpIntervalSet[T]) IsEmpty() bool {
+	return len(set.s.spans) == 0
+}
+
+// Intervals returns the disjoint intervals of this set in ascending order
+func (set *CmpIntervalSet[T]) Intervals() []*CmpInterval[T] {
+	intervals := make([]*CmpInterval[T], 0, len(set.s.spans))
+	for _, sp := range set.s.spans {
+		intervals = append(intervals, newCmpIntervalCuts(set.s.cmp, sp.lower, sp.upper))
+	}
+	return intervals
+}
+
+// String returns a readable string of this set like "[1,2) ∪ [3,4]"
+func (set *CmpIntervalSet[T]) String() string {
+	if set.IsEmpty() {
+		return EmptySet
+	}
+	strs := make([]string, 0, len(set.s.spans))
+	for _, ci := range set.Intervals() {
+		strs = append(strs, ci.String())
+	}
+	return strings.Join(strs, UnionSpacer)
+}
@@ -1,105 +1,75 @@
 package interval
 
 import (
-	"bytes"
 	"time"
 )
 
 // TimeInterval time interval
 type TimeInterval struct {
-	left           time.Time
-	right          time.Time
-	openClosedType OpenClosedType
+	bounds[time.Time]
 }
 
 // NewTimeInterval return a new TimeInterval
 func NewTimeInterval(left, right time.Time, openCloseType ...OpenClosedType) *TimeInterval {
-	t := Default
-	if len(openCloseType) > 0 {
-		t = openCloseType[0]
-	}
-	return &TimeInterval{
-		left:           left,
-		right:          right,
-		openClosedType: t,
-	}
+	return &TimeInterval{bounds: newBounds(left, right, openCloseType)}
+}
+
+// NewTimeIntervalFromBounds return a new TimeInterval between the given bounds, which may be Unbounded
+func NewTimeIntervalFromBounds(lower, upper Bound[time.Time]) *TimeInterval {
+	return &TimeInterval{bounds: bounds[time.Time]{lower: lower, upper: upper}}
+}
+
+func newTimeIntervalCuts(lower, upper cut[time.Time]) *TimeInterval {
+	return NewTimeIntervalFromBounds(lowerBound(lower), upperBound(upper))
 }
 
 // ParseTimeInterval parse str to interval
 func ParseTimeInterval(intervalStr string, layout ...string) (ti *TimeInterval, err error) {
-	var lf, lv, rv, rf string
-	if lf, lv, rv, rf, err = blowUp(intervalStr); err != nil {
-		return
-	}
+	return DefaultGrammar.ParseTimeInterval(intervalStr, layout...)
+}
+
+// ParseTimeInterval parse str to interval with this grammar, the infinity tokens are parsed to Unbounded ends
+func (g *Grammar) ParseTimeInterval(intervalStr string, layout ...string) (ti *TimeInterval, err error) {
 	var openClosedType OpenClosedType
-	if openClosedType, err = getOpenClosedType(lf, rf); err != nil {
-		return nil, err
+	var lv, rv string
+	if openClosedType, lv, rv, err = g.blowUp(intervalStr); err != nil {
+		return
 	}
 	l := defaultTimeLayout
 	if len(layout) > 0 {
 		l = layout[0]
 	}
-	var lt, rt time.Time
-	if lt, err = time.Parse(l, lv); err != nil {
-		return nil, err
-	}
-	if rt, err = time.Parse(l, rv); err != nil {
+	var lower, upper Bound[time.Time]
+	if lower, upper, err = parseBounds(g, openClosedType, lv, rv, func(v string) (time.Time, error) {
+		return time.Parse(l, v)
+	}); err != nil {
 		return nil, err
 	}
-	return NewTimeInterval(lt, rt, openClosedType), nil
+	return NewTimeIntervalFromBounds(lower, upper), nil
 }
 
-// Left returns the left value of this interval
+// Left returns the left value of this interval, or the zero time if it is Unbounded
 func (ti *TimeInterval) Left() time.Time {
-	return ti.left
+	return ti.lower.value
 }
 
-// Right returns the right value of this interval
+// Right returns the right value of this interval, or the zero time if it is Unbounded
 func (ti *TimeInterval) Right() time.Time {
-	return ti.right
-}
-
-// OpenClosedType returns the OpenClosedType of this interval
-func (ti *TimeInterval) OpenClosedType() OpenClosedType {
-	return ti.openClosedType
-}
-
-// LeftClosed returns true if interval is a left-closed interval
-func (ti *TimeInterval) LeftClosed() bool {
-	return ti.openClosedType&ClosedOpen == ClosedOpen
-}
-
-// RightClosed returns true if interval is a right-closed interval
-func (ti *TimeInterval) RightClosed() bool {
-	return ti.openClosedType&OpenClosed == OpenClosed
+	return ti.upper.value
 }
 
 // Contains return ture if this interval contains the given element
 func (ti *TimeInterval) Contains(e time.Time) bool {
-	return (e.After(ti.left) && e.Before(ti.right)) ||
-		(e.Equal(ti.left) && ti.openClosedType&ClosedOpen == ClosedOpen) ||
-		(e.Equal(ti.right) && ti.openClosedType&OpenClosed == OpenClosed)
+	return ti.contains(compareTime, e)
 }
 
-// String returns a readable string of this interval
-func (ti *TimeInterval) String(layout ...string) string {
-	l := defaultTimeLayout
-	if len(layout) > 0 {
-		l = layout[0]
-	}
-	bs := bytes.Buffer{}
-	if ti.LeftClosed() {
-		bs.WriteString(LeftClosed)
-	} else {
-		bs.WriteString(LeftOpen)
-	}
-	bs.WriteString(ti.left.Format(l))
-	bs.WriteString(Spacer)
-	bs.WriteString(ti.right.Format(l))
-	if ti.RightClosed() {
-		bs.WriteString(RightClosed)
-	} else {
-		bs.WriteString(RightOpen)
-	}
-	return bs.String()
+// String returns a readable string of this interval with the times in RFC3339
+func (ti *TimeInterval) String() string {
+	return ti.FormatLayout(defaultTimeLayout)
+}
+
+// FormatLayout returns a readable string of this interval with the times in the given layout,
+// Unbounded ends are written as "-inf" and "+inf"
+func (ti *TimeInterval) FormatLayout(layout string) string {
+	return ti.string(func(t time.Time) string { return t.Format(layout) }, "-inf", "+inf")
 }
@@ -0,0 +1,163 @@
+package interval
+
+import (
+	"time"
+)
+
+// The transformations return a new interval and keep the kinds of the bounds, Unbounded ends stay Unbounded.
+// Integer values wrap on overflow like Go arithmetic
+
+// mapBounds applies lower and upper to the values of the bounded ends, decreasing functions swap the ends
+func (b *bounds[T]) mapBounds(lower, upper func(T) T, decreasing bool) bounds[T] {
+	m := *b
+	if m.lower.kind != Unbounded {
+		m.lower.value = lower(m.lower.value)
+	}
+	if m.upper.kind != Unbounded {
+		m.upper.value = upper(m.upper.value)
+	}
+	if decreasing {
+		m.lower, m.upper = m.upper, m.lower
+	}
+	return m
+}
+
+// withType returns b with the kinds of the bounded ends set by openClosedType
+func (b *bounds[T]) withType(openClosedType OpenClosedType) bounds[T] {
+	w := *b
+	if w.lower.kind != Unbounded {
+		w.lower = newBound(w.lower.value, openClosedType&ClosedOpen == ClosedOpen)
+	}
+	if w.upper.kind != Unbounded {
+		w.upper = newBound(w.upper.value, openClosedType&OpenClosed == OpenClosed)
+	}
+	return w
+}
+
+// clamp returns the nearest value of b to v, d is nil for continuous domains
+// where an open end has no nearest value
+func (b *bounds[T]) clamp(cmp func(a, b T) int, v T, d *discreteDomain[T]) (T, error) {
+	if compareLowerUpper(cmp, b.lower, b.upper) >= 0 {
+		return v, ClampEmptyErr
+	}
+	if b.contains(cmp, v) {
+		return v, nil
+	}
+	below := compareCut(cmp, b.lower.lowerCut(), cut[T]{value: v}) > 0
+	end := b.upper
+	if below {
+		end = b.lower
+	}
+	c, ok := end.value, end.kind == Included
+	switch {
+	case ok || d == nil:
+	case below:
+		c, ok = d.next(c)
+	default:
+		c, ok = d.prev(c)
+	}
+	if !ok {
+		return v, ClampOpenEndErr
+	}
+	if !b.contains(cmp, c) {
+		// a discrete interval like (1,2) has no member
+		return v, ClampEmptyErr
+	}
+	return c, nil
+}
+
+// WithLeft returns a copy of this interval with the left value v, an Unbounded left end becomes open
+func (bi *BaseInterval[T]) WithLeft(v T) *BaseInterval[T] {
+	return NewBaseIntervalFromBounds(newBound(v, bi.LeftClosed()), bi.upper)
+}
+
+// WithRight returns a copy of this interval with the right value v, an Unbounded right end becomes open
+func (bi *BaseInterval[T]) WithRight(v T) *BaseInterval[T] {
+	return NewBaseIntervalFromBounds(bi.lower, newBound(v, bi.RightClosed()))
+}
+
+// WithType returns a copy of this interval with the given OpenClosedType, Unbounded ends stay Unbounded
+func (bi *BaseInterval[T]) WithType(openClosedType OpenClosedType) *BaseInterval[T] {
+	return &BaseInterval[T]{bounds: bi.withType(openClosedType)}
+}
+
+// Shift returns the interval moved by delta
+func Shift[T number](bi *BaseInterval[T], delta T) *BaseInterval[T] {
+	shift := func(v T) T { return v + delta }
+	return &BaseInterval[T]{bounds: bi.mapBounds(shift, shift, false)}
+}
+
+// Scale returns the interval scaled by factor around pivot, each value v becomes pivot+(v-pivot)*factor.
+// A negative factor mirrors the interval and swaps its ends, a zero factor shrinks the bounded ends to pivot
+func Scale[T number](bi *BaseInterval[T], factor, pivot T) *BaseInterval[T] {
+	scale := func(v T) T { return pivot + (v-pivot)*factor }
+	return &BaseInterval[T]{bounds: bi.mapBounds(scale, scale, factor < 0)}
+}
+
+// Expand returns the interval widened by by on both sides
+func Expand[T number](bi *BaseInterval[T], by T) *BaseInterval[T] {
+	return &BaseInterval[T]{bounds: bi.mapBounds(func(v T) T { return v - by }, func(v T) T { return v + by }, false)}
+}
+
+// Shrink returns the interval narrowed by by on both sides, the result is empty if by exceeds half of the width
+func Shrink[T number](bi *BaseInterval[T], by T) *BaseInterval[T] {
+	return &BaseInterval[T]{bounds: bi.mapBounds(func(v T) T { return v + by }, func(v T) T { return v - by }, false)}
+}
+
+// Clamp returns the point of the interval nearest to v, v itself if it is contained.
+// The nearest point to an open integer end is its neighbour, an open float end has none and returns
+// ClampOpenEndErr. An empty interval returns ClampEmptyErr and NaN is returned as is
+func Clamp[T number](bi *BaseInterval[T], v T) (T, error) {
+	if v != v {
+		return v, nil
+	}
+	return bi.clamp(compareBase[T], v, numberDomain[T]())
+}
+
+// Shift returns this interval moved by d
+func (ti *TimeInterval) Shift(d time.Duration) *TimeInterval {
+	shift := func(t time.Time) time.Time { return t.Add(d) }
+	return &TimeInterval{bounds: ti.mapBounds(shift, shift, false)}
+}
+
+// Scale returns this interval scaled by factor around pivot, each time t becomes pivot+(t-pivot)*factor.
+// A negative factor mirrors the interval and swaps its ends, a zero factor shrinks the bounded ends to pivot
+func (ti *TimeInterval) Scale(factor float64, pivot time.Time) *TimeInterval {
+	scale := func(t time.Time) time.Time {
+		return pivot.Add(time.Duration(float64(t.Sub(pivot)) * factor))
+	}
+	return &TimeInterval{bounds: ti.mapBounds(scale, scale, factor < 0)}
+}
+
+// Expand returns this interval widened by d on both sides
+func (ti *TimeInterval) Expand(d time.Duration) *TimeInterval {
+	return &TimeInterval{bounds: ti.mapBounds(func(t time.Time) time.Time { return t.Add(-d) },
+		func(t time.Time) time.Time { return t.Add(d) }, false)}
+}
+
+// Shrink returns this interval narrowed by d on both sides, the result is empty if d exceeds half of the duration
+func (ti *TimeInterval) Shrink(d time.Duration) *TimeInterval {
+	return ti.Expand(-d)
+}
+
+// WithLeft returns a copy of this interval with the left time t, an Unbounded left end becomes open
+func (ti *TimeInterval) WithLeft(t time.Time) *TimeInterval {
+	return NewTimeIntervalFromBounds(newBound(t, ti.LeftClosed()), ti.upper)
+}
+
+// WithRight returns a copy of this interval with the right time t, an Unbounded right end becomes open
+func (ti *TimeInterval) WithRight(t time.Time) *TimeInterval {
+	return NewTimeIntervalFromBounds(ti.lower, newBound(t, ti.RightClosed()))
+}
+
+// WithType returns a copy of this interval with the given OpenClosedType, Unbounded ends stay Unbounded
+func (ti *TimeInterval) WithType(openClosedType OpenClosedType) *TimeInterval {
+	return &TimeInterval{bounds: ti.withType(openClosedType)}
+}
+
+// Clamp returns the time of this interval nearest to t, t itself if it is contained.
+// Times are continuous, so an open end has no nearest time and returns ClampOpenEndErr,
+// an empty interval returns ClampEmptyErr
+func (ti *TimeInterval) Clamp(t time.Time) (time.Time, error) {
+	return ti.clamp(compareTime, t, nil)
+}
@@ -0,0 +1,94 @@
+package interval
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBaseInterval_Transform(t *testing.T) {
+	bi := NewBaseInterval(1, 5, ClosedOpen)
+	tests := []struct {
+		name string
+		got  *BaseInterval[int]
+		want string
+	}{
+		{"Shift", Shift(bi, 10), "[11,15)"},
+		{"Scale", Scale(bi, 2, 1), "[1,9)"},
+		{"ScaleNegative", Scale(bi, -1, 0), "(-5,-1]"},
+		{"Expand", Expand(bi, 2), "[-1,7)"},
+		{"Shrink", Shrink(bi, 1), "[2,4)"},
+		{"WithLeft", bi.WithLeft(0), "[0,5)"},
+		{"WithRight", bi.WithRight(9), "[1,9)"},
+		{"WithType", bi.WithType(OpenClosed), "(1,5]"},
+		{"Unbounded", Shift(NewBaseIntervalFromBounds(UnboundedBound[int](), IncludedBound(3)), 1), "(-inf,4]"},
+		{"WithLeftUnbounded", NewBaseIntervalFromBounds(UnboundedBound[int](), IncludedBound(3)).WithLeft(0), "(0,3]"},
+	}
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	if bi.String() != "[1,5)" {
+		t.Errorf("transformations changed %v", bi)
+	}
+}
+
+func TestClamp(t *testing.T) {
+	tests := []struct {
+		bi      *BaseInterval[float64]
+		v, want float64
+		wantErr error
+	}{
+		{NewBaseInterval(1.0, 5.0, Closed), 3, 3, nil},
+		{NewBaseInterval(1.0, 5.0, Closed), 0, 1, nil},
+		{NewBaseInterval(1.0, 5.0, Closed), 9, 5, nil},
+		{NewBaseInterval(1.0, 5.0, ClosedOpen), 9, 9, ClampOpenEndErr},
+		{NewBaseInterval(1.0, 1.0, ClosedOpen), 0, 0, ClampEmptyErr},
+		{NewBaseInterval(1.0, math.Inf(1), Closed), 1e300, 1e300, nil},
+	}
+	for _, tt := range tests {
+		if got, err := Clamp(tt.bi, tt.v); got != tt.want || !errors.Is(err, tt.wantErr) {
+			t.Errorf("Clamp(%v, %v) = %v, %v, want %v, %v", tt.bi, tt.v, got, err, tt.want, tt.wantErr)
+		}
+	}
+	if got, err := Clamp(NewBaseInterval(1, 5, Open), 9); got != 4 || err != nil {
+		t.Errorf("Clamp() = %v, %v", got, err)
+	}
+	if got, err := Clamp(NewBaseInterval[uint8](1, 5, Open), 0); got != 2 || err != nil {
+		t.Errorf("Clamp() = %v, %v", got, err)
+	}
+	if _, err := Clamp(NewBaseInterval(1, 2, Open), 0); !errors.Is(err, ClampEmptyErr) {
+		t.Errorf("Clamp() err = %v", err)
+	}
+}
+
+func TestTimeInterval_Transform(t *testing.T) {
+	t1 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ti := NewTimeInterval(t1, t1.Add(2*time.Hour))
+	if got := ti.Shift(time.Hour); !got.Left().Equal(t1.Add(time.Hour)) || !got.Right().Equal(t1.Add(3*time.Hour)) {
+		t.Errorf("Shift() = %v", got)
+	}
+	if got := ti.Scale(0.5, t1); !got.Right().Equal(t1.Add(time.Hour)) || !got.LeftClosed() {
+		t.Errorf("Scale() = %v", got)
+	}
+	if got := ti.Scale(-1, t1); !got.Left().Equal(t1.Add(-2*time.Hour)) || got.LeftClosed() || !got.RightClosed() {
+		t.Errorf("Scale() = %v", got)
+	}
+	if got := ti.Expand(time.Hour); !got.Left().Equal(t1.Add(-time.Hour)) || !got.Right().Equal(t1.Add(3*time.Hour)) {
+		t.Errorf("Expand() = %v", got)
+	}
+	if got := ti.Shrink(2 * time.Hour); got.Contains(t1) || got.Contains(t1.Add(time.Hour)) {
+		t.Errorf("Shrink() = %v", got)
+	}
+	if got := ti.WithType(Closed).WithRight(t1); got.String() != NewTimeInterval(t1, t1, Closed).String() {
+		t.Errorf("WithType().WithRight() = %v", got)
+	}
+	if got, err := ti.Clamp(t1.Add(-time.Hour)); !got.Equal(t1) || err != nil {
+		t.Errorf("Clamp() = %v, %v", got, err)
+	}
+	if _, err := ti.Clamp(t1.Add(3 * time.Hour)); !errors.Is(err, ClampOpenEndErr) {
+		t.Errorf("Clamp() err = %v", err)
+	}
+}